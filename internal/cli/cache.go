@@ -2,9 +2,13 @@ package cli
 
 import (
 	"bufio"
+	"context"
 	"fmt"
+	"io"
 	"os"
+	"sort"
 	"strings"
+	"text/tabwriter"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -33,21 +37,173 @@ Use --stale to only remove entries older than one week.`,
 	RunE: runCacheClear,
 }
 
+var cacheListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List cached reviews",
+	Long: `List cached reviews, newest first, with the key, base ref, commit count,
+and time each was cached. Use a key with 'graft cache export'.`,
+	Args: cobra.NoArgs,
+	RunE: runCacheList,
+}
+
+var cacheExportCmd = &cobra.Command{
+	Use:   "export <key> <file>",
+	Short: "Export a cached review to a file",
+	Long: `Export a cached review so teammates can reuse it without repeating the AI requests.
+
+Run 'graft cache list' to find the key. The exported file can be
+committed to a branch or uploaded as a build artifact, then loaded with
+'graft cache import'.`,
+	Args: cobra.ExactArgs(2),
+	RunE: runCacheExport,
+}
+
+var cacheImportCmd = &cobra.Command{
+	Use:   "import <file>",
+	Short: "Import a review exported by 'graft cache export'",
+	Long: `Import a cached review exported by a teammate.
+
+The review is only imported if it was made for exactly the commits between
+its base ref and the current branch, so stale or unrelated reviews are rejected.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runCacheImport,
+}
+
 func init() {
 	cacheClearCmd.Flags().BoolVar(&staleOnly, "stale", false, "Only remove cache entries older than one week")
 
 	cacheCmd.AddCommand(cacheClearCmd)
+	cacheCmd.AddCommand(cacheListCmd)
+	cacheCmd.AddCommand(cacheExportCmd)
+	cacheCmd.AddCommand(cacheImportCmd)
 	rootCmd.AddCommand(cacheCmd)
 }
 
-func runCacheClear(cmd *cobra.Command, args []string) error {
-	// Find repository root
+// openRepository opens the git repository containing the working directory.
+func openRepository() (*git.Repository, error) {
 	repo, err := git.NewRepository("")
 	if err != nil {
 		if err == git.ErrNotARepository {
-			return fmt.Errorf("not in a git repository")
+			return nil, fmt.Errorf("not in a git repository")
 		}
-		return fmt.Errorf("opening repository: %w", err)
+		return nil, fmt.Errorf("opening repository: %w", err)
+	}
+	return repo, nil
+}
+
+func runCacheList(cmd *cobra.Command, args []string) error {
+	repo, err := openRepository()
+	if err != nil {
+		return err
+	}
+
+	repoDir, err := repo.GetRootDir(cmd.Context())
+	if err != nil {
+		return fmt.Errorf("getting repo root: %w", err)
+	}
+
+	reviews, err := provider.NewReviewCache(repoDir).List()
+	if err != nil {
+		return fmt.Errorf("listing cache: %w", err)
+	}
+
+	if len(reviews) == 0 {
+		fmt.Fprintln(cmd.OutOrStdout(), "No cached reviews found.")
+		return nil
+	}
+	return writeCacheList(cmd.OutOrStdout(), reviews)
+}
+
+// writeCacheList prints cached reviews as an aligned table, newest first.
+func writeCacheList(w io.Writer, reviews []*provider.CachedReview) error {
+	sorted := make([]*provider.CachedReview, len(reviews))
+	copy(sorted, reviews)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].CachedAt.After(sorted[j].CachedAt)
+	})
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "KEY\tBASE\tCOMMITS\tCACHED")
+	for _, r := range sorted {
+		fmt.Fprintf(tw, "%s\t%s\t%d\t%s\n",
+			r.CacheKey, r.BaseRef, len(r.CommitHashes), r.CachedAt.Local().Format("2006-01-02 15:04"))
+	}
+	return tw.Flush()
+}
+
+func runCacheExport(cmd *cobra.Command, args []string) error {
+	repo, err := openRepository()
+	if err != nil {
+		return err
+	}
+
+	repoDir, err := repo.GetRootDir(cmd.Context())
+	if err != nil {
+		return fmt.Errorf("getting repo root: %w", err)
+	}
+
+	if err := provider.NewReviewCache(repoDir).Export(args[0], args[1]); err != nil {
+		return err
+	}
+
+	fmt.Printf("Exported cached review %s to %s\n", args[0], args[1])
+	return nil
+}
+
+func runCacheImport(cmd *cobra.Command, args []string) error {
+	repo, err := openRepository()
+	if err != nil {
+		return err
+	}
+
+	cached, err := importReview(cmd.Context(), repo, args[0])
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Imported cached review %s (%d commit(s) against %s)\n",
+		cached.CacheKey, len(cached.CommitHashes), cached.BaseRef)
+	return nil
+}
+
+// importReview reads an exported review and saves it to the repository's cache
+// after checking that it matches the commits between its base ref and HEAD.
+func importReview(ctx context.Context, repo *git.Repository, path string) (*provider.CachedReview, error) {
+	cached, err := provider.ReadExportedReview(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := repo.ValidateBranch(ctx, cached.BaseRef); err != nil {
+		return nil, fmt.Errorf("exported review base: %w", err)
+	}
+
+	commits, err := repo.GetCommits(ctx, cached.BaseRef)
+	if err != nil {
+		return nil, fmt.Errorf("getting commits: %w", err)
+	}
+
+	if err := cached.ValidateCommits(commits); err != nil {
+		return nil, fmt.Errorf("cannot import %s: %w", path, err)
+	}
+
+	repoDir, err := repo.GetRootDir(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("getting repo root: %w", err)
+	}
+
+	if err := provider.NewReviewCache(repoDir).Save(cached); err != nil {
+		return nil, err
+	}
+
+	return cached, nil
+}
+
+func runCacheClear(cmd *cobra.Command, args []string) error {
+	// Find repository root
+	repo, err := openRepository()
+	if err != nil {
+		return err
 	}
 
 	repoDir, err := repo.GetRootDir(cmd.Context())
@@ -0,0 +1,176 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mwistrand/graft/internal/git"
+	"github.com/mwistrand/graft/internal/provider"
+)
+
+// setupFeatureBranch creates a repository with a feature branch two commits ahead of main.
+func setupFeatureBranch(t *testing.T) (string, *git.Repository) {
+	t.Helper()
+
+	dir := setupGitRepo(t)
+	runGit(t, dir, "checkout", "-b", "feature")
+	runGit(t, dir, "commit", "--allow-empty", "-m", "First change")
+	runGit(t, dir, "commit", "--allow-empty", "-m", "Second change")
+
+	repo, err := git.NewRepository(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dir, repo
+}
+
+// cacheCurrentReview saves a review for the current branch against main, as 'graft review' would.
+func cacheCurrentReview(t *testing.T, dir string, repo *git.Repository) *provider.CachedReview {
+	t.Helper()
+
+	commits, err := repo.GetCommits(context.Background(), "main")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hashes := make([]string, len(commits))
+	for i, c := range commits {
+		hashes[i] = c.Hash
+	}
+
+	cached := &provider.CachedReview{
+		CacheKey:     provider.GenerateCacheKey("main", commits),
+		BaseRef:      "main",
+		CommitHashes: hashes,
+		Summary:      &provider.SummarizeResponse{Overview: "Shared summary"},
+		CachedAt:     time.Now(),
+	}
+	if err := provider.NewReviewCache(dir).Save(cached); err != nil {
+		t.Fatal(err)
+	}
+	return cached
+}
+
+func TestCacheExportImport_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	dir, repo := setupFeatureBranch(t)
+	original := cacheCurrentReview(t, dir, repo)
+
+	path := filepath.Join(t.TempDir(), "review.json")
+	cache := provider.NewReviewCache(dir)
+	if err := cache.Export(original.CacheKey, path); err != nil {
+		t.Fatalf("Export() failed: %v", err)
+	}
+
+	// Simulate a teammate without the review cached
+	if err := cache.ClearAll(); err != nil {
+		t.Fatal(err)
+	}
+
+	imported, err := importReview(ctx, repo, path)
+	if err != nil {
+		t.Fatalf("importReview() failed: %v", err)
+	}
+	if imported.CacheKey != original.CacheKey {
+		t.Errorf("CacheKey = %q, want %q", imported.CacheKey, original.CacheKey)
+	}
+
+	loaded, err := cache.Load(original.CacheKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loaded == nil || loaded.Summary == nil || loaded.Summary.Overview != "Shared summary" {
+		t.Errorf("imported review should be usable from the cache, got %+v", loaded)
+	}
+}
+
+func TestCacheImport_RejectsMismatchedCommits(t *testing.T) {
+	ctx := context.Background()
+	dir, repo := setupFeatureBranch(t)
+	original := cacheCurrentReview(t, dir, repo)
+
+	path := filepath.Join(t.TempDir(), "review.json")
+	cache := provider.NewReviewCache(dir)
+	if err := cache.Export(original.CacheKey, path); err != nil {
+		t.Fatal(err)
+	}
+	if err := cache.ClearAll(); err != nil {
+		t.Fatal(err)
+	}
+
+	// The branch moves on after the review was exported
+	runGit(t, dir, "commit", "--allow-empty", "-m", "Third change")
+
+	_, err := importReview(ctx, repo, path)
+	if !errors.Is(err, provider.ErrCommitMismatch) {
+		t.Fatalf("importReview() error = %v, want ErrCommitMismatch", err)
+	}
+	if cache.Exists(original.CacheKey) {
+		t.Error("rejected review should not be saved to the cache")
+	}
+}
+
+func TestCacheImport_UnknownBaseRef(t *testing.T) {
+	ctx := context.Background()
+	dir, repo := setupFeatureBranch(t)
+	original := cacheCurrentReview(t, dir, repo)
+
+	path := filepath.Join(t.TempDir(), "review.json")
+	if err := provider.NewReviewCache(dir).Export(original.CacheKey, path); err != nil {
+		t.Fatal(err)
+	}
+
+	// A repository without the review's base branch
+	other := setupGitRepo(t)
+	runGit(t, other, "branch", "-m", "trunk")
+	otherRepo, err := git.NewRepository(other)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := importReview(ctx, otherRepo, path); err == nil {
+		t.Error("expected error when the base ref does not exist")
+	}
+}
+
+func TestWriteCacheList(t *testing.T) {
+	now := time.Now()
+	reviews := []*provider.CachedReview{
+		{CacheKey: "olderkey00000000", BaseRef: "main", CommitHashes: []string{"a"}, CachedAt: now.Add(-48 * time.Hour)},
+		{CacheKey: "newerkey00000000", BaseRef: "origin/develop", CommitHashes: []string{"b", "c"}, CachedAt: now},
+	}
+
+	var buf bytes.Buffer
+	if err := writeCacheList(&buf, reviews); err != nil {
+		t.Fatalf("writeCacheList() failed: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected a header and 2 rows, got:\n%s", buf.String())
+	}
+	if !strings.HasPrefix(lines[0], "KEY") {
+		t.Errorf("first line should be the header: %q", lines[0])
+	}
+
+	// Newest first, with base ref and commit count
+	if fields := strings.Fields(lines[1]); len(fields) < 3 || fields[0] != "newerkey00000000" || fields[1] != "origin/develop" || fields[2] != "2" {
+		t.Errorf("unexpected first row: %q", lines[1])
+	}
+	if !strings.HasPrefix(lines[2], "olderkey00000000") {
+		t.Errorf("unexpected second row: %q", lines[2])
+	}
+	if !strings.Contains(lines[1], now.Local().Format("2006-01-02")) {
+		t.Errorf("row should include the cache date: %q", lines[1])
+	}
+
+	// The caller's order is left unchanged
+	if reviews[0].CacheKey != "olderkey00000000" {
+		t.Error("writeCacheList() should not reorder its input")
+	}
+}
@@ -2,8 +2,10 @@ package cli
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/mwistrand/graft/internal/config"
+	"github.com/mwistrand/graft/internal/prompt"
 	"github.com/spf13/cobra"
 )
 
@@ -13,12 +15,16 @@ var configCmd = &cobra.Command{
 	Long: `View and modify graft configuration.
 
 Available keys:
-  provider          AI provider to use (claude, copilot)
-  model             Model name for the selected provider
-  anthropic-api-key API key for Claude/Anthropic
-  openai-api-key    API key for OpenAI
-  copilot-base-url  URL of copilot-api proxy (default: http://localhost:4141)
-  delta-path        Path to delta binary`,
+  provider           AI provider to use (claude, copilot)
+  model              Model name for the selected provider
+  anthropic-api-key  API key for Claude/Anthropic
+  openai-api-key     API key for OpenAI
+  copilot-base-url   URL of copilot-api proxy (default: http://localhost:4141)
+  delta-path         Path to delta binary
+  concern-keywords   Comma-separated keywords to flag in added lines
+  summary-max-tokens Response token budget for summaries (default: per model)
+  order-max-tokens   Response token budget for file ordering (default: per model)
+  review-max-tokens  Response token budget for reviews (default: per model)`,
 	Run: func(cmd *cobra.Command, args []string) {
 		showConfig()
 	},
@@ -48,27 +54,70 @@ var configGetCmd = &cobra.Command{
 	},
 }
 
+var configSetStdin bool
+
 var configSetCmd = &cobra.Command{
-	Use:   "set <key> <value>",
+	Use:   "set <key> [value]",
 	Short: "Set a configuration value",
-	Args:  cobra.ExactArgs(2),
-	RunE: func(cmd *cobra.Command, args []string) error {
-		cfg, err := config.Load()
-		if err != nil {
-			return fmt.Errorf("loading config: %w", err)
+	Long: `Set a configuration value.
+
+To keep API keys out of your shell history, pass "-" as the value or use
+--stdin. The key is then read from stdin, without echo when typed at a
+terminal. Reading from stdin is supported for: ` + strings.Join(config.SecretKeys, ", ") + `.
+
+Example:
+  graft config set provider copilot
+  graft config set anthropic-api-key -             Prompt for the key
+  pass show anthropic | graft config set anthropic-api-key --stdin`,
+	Args: validateConfigSetArgs,
+	RunE: runConfigSet,
+}
+
+// validateConfigSetArgs requires a key and value, or only a key with --stdin.
+func validateConfigSetArgs(cmd *cobra.Command, args []string) error {
+	if configSetStdin {
+		if len(args) == 2 && args[1] != "-" {
+			return fmt.Errorf("cannot combine a value with --stdin")
 		}
+		return cobra.RangeArgs(1, 2)(cmd, args)
+	}
+	return cobra.ExactArgs(2)(cmd, args)
+}
 
-		if err := cfg.Set(args[0], args[1]); err != nil {
-			return err
+func runConfigSet(cmd *cobra.Command, args []string) error {
+	key := args[0]
+
+	var value string
+	if configSetStdin || args[1] == "-" {
+		if !config.IsSecretKey(key) {
+			return fmt.Errorf("reading %s from stdin is not supported; only secret keys can be read from stdin (%s)",
+				key, strings.Join(config.SecretKeys, ", "))
 		}
 
-		if err := cfg.Save(); err != nil {
-			return fmt.Errorf("saving config: %w", err)
+		secret, err := prompt.ReadSecret(cmd.InOrStdin(), cmd.ErrOrStderr(), "Enter "+key)
+		if err != nil {
+			return err
 		}
+		value = secret
+	} else {
+		value = args[1]
+	}
 
-		fmt.Printf("Set %s\n", args[0])
-		return nil
-	},
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("loading config: %w", err)
+	}
+
+	if err := cfg.Set(key, value); err != nil {
+		return err
+	}
+
+	if err := cfg.Save(); err != nil {
+		return fmt.Errorf("saving config: %w", err)
+	}
+
+	fmt.Fprintf(cmd.OutOrStdout(), "Set %s\n", key)
+	return nil
 }
 
 var configPathCmd = &cobra.Command{
@@ -85,6 +134,8 @@ var configPathCmd = &cobra.Command{
 }
 
 func init() {
+	configSetCmd.Flags().BoolVar(&configSetStdin, "stdin", false, "Read a secret value from stdin")
+
 	configCmd.AddCommand(configGetCmd)
 	configCmd.AddCommand(configSetCmd)
 	configCmd.AddCommand(configPathCmd)
@@ -100,7 +151,7 @@ func showConfig() {
 	fmt.Println("Current configuration:")
 	fmt.Println()
 
-	keys := []string{"provider", "model", "anthropic-api-key", "openai-api-key", "copilot-base-url", "delta-path"}
+	keys := []string{"provider", "model", "anthropic-api-key", "openai-api-key", "copilot-base-url", "delta-path", "concern-keywords", "summary-max-tokens", "order-max-tokens", "review-max-tokens"}
 	for _, key := range keys {
 		value, _ := cfg.Get(key)
 		if value == "" {
@@ -0,0 +1,114 @@
+package cli
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/mwistrand/graft/internal/config"
+)
+
+// runConfigSetWithStdin runs 'graft config set' with stdin set to input.
+func runConfigSetWithStdin(t *testing.T, input string, useFlag bool, args ...string) error {
+	t.Helper()
+
+	configSetStdin = useFlag
+	var out bytes.Buffer
+	configSetCmd.SetIn(strings.NewReader(input))
+	configSetCmd.SetOut(&out)
+	configSetCmd.SetErr(&out)
+	t.Cleanup(func() {
+		configSetStdin = false
+		configSetCmd.SetIn(nil)
+		configSetCmd.SetOut(nil)
+		configSetCmd.SetErr(nil)
+	})
+
+	if err := validateConfigSetArgs(configSetCmd, args); err != nil {
+		return err
+	}
+	return runConfigSet(configSetCmd, args)
+}
+
+func TestConfigSet_SecretFromStdin(t *testing.T) {
+	tests := []struct {
+		name    string
+		useFlag bool
+		args    []string
+	}{
+		{"dash value", false, []string{"anthropic-api-key", "-"}},
+		{"stdin flag", true, []string{"anthropic-api-key"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			isolateConfig(t)
+
+			if err := runConfigSetWithStdin(t, "sk-ant-from-stdin\n", tt.useFlag, tt.args...); err != nil {
+				t.Fatalf("config set failed: %v", err)
+			}
+
+			cfg, err := config.Load()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if cfg.AnthropicAPIKey != "sk-ant-from-stdin" {
+				t.Errorf("AnthropicAPIKey = %q, want %q", cfg.AnthropicAPIKey, "sk-ant-from-stdin")
+			}
+		})
+	}
+}
+
+func TestConfigSet_AllSecretKeysFromStdin(t *testing.T) {
+	for _, key := range config.SecretKeys {
+		t.Run(key, func(t *testing.T) {
+			isolateConfig(t)
+
+			if err := runConfigSetWithStdin(t, "secret-for-"+key+"\n", false, key, "-"); err != nil {
+				t.Fatalf("config set failed: %v", err)
+			}
+
+			cfg, err := config.Load()
+			if err != nil {
+				t.Fatal(err)
+			}
+			value, _ := cfg.Get(key)
+			if value == "" {
+				t.Errorf("%s should be set", key)
+			}
+		})
+	}
+}
+
+func TestConfigSet_StdinRejected(t *testing.T) {
+	isolateConfig(t)
+
+	if err := runConfigSetWithStdin(t, "copilot\n", false, "provider", "-"); err == nil {
+		t.Error("expected error reading a non-secret key from stdin")
+	}
+	if err := runConfigSetWithStdin(t, "", false, "anthropic-api-key", "-"); err == nil {
+		t.Error("expected error for empty stdin")
+	}
+	if err := runConfigSetWithStdin(t, "sk-ant\n", true, "anthropic-api-key", "sk-ant-inline"); err == nil {
+		t.Error("expected error combining a value with --stdin")
+	}
+	if err := runConfigSetWithStdin(t, "", false, "anthropic-api-key"); err == nil {
+		t.Error("expected error when the value is missing without --stdin")
+	}
+}
+
+func TestConfigSet_Value(t *testing.T) {
+	isolateConfig(t)
+
+	if err := runConfigSetWithStdin(t, "", false, "provider", "copilot"); err != nil {
+		t.Fatalf("config set failed: %v", err)
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Provider != "copilot" {
+		t.Errorf("Provider = %q, want %q", cfg.Provider, "copilot")
+	}
+}
@@ -0,0 +1,40 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/mwistrand/graft/internal/provider"
+	"github.com/mwistrand/graft/internal/render"
+)
+
+// githubSummaryEnv names the file GitHub Actions renders as the job summary.
+const githubSummaryEnv = "GITHUB_STEP_SUMMARY"
+
+// writeGitHubSummary appends the summary and review order as Markdown to the
+// GitHub Actions job summary file. It does nothing when not running in
+// GitHub Actions or when there is nothing to report.
+func writeGitHubSummary(title string, summary *provider.SummarizeResponse, order *provider.OrderResponse) error {
+	path := os.Getenv(githubSummaryEnv)
+	if path == "" {
+		Verbose("Skipping GitHub job summary: %s is not set", githubSummaryEnv)
+		return nil
+	}
+	if summary == nil && order == nil {
+		Verbose("Skipping GitHub job summary: no summary or ordering was generated")
+		return nil
+	}
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("opening %s: %w", githubSummaryEnv, err)
+	}
+	defer f.Close()
+
+	if err := render.WriteMarkdownReport(f, title, summary, order); err != nil {
+		return fmt.Errorf("writing job summary: %w", err)
+	}
+
+	Verbose("Wrote GitHub job summary to %s", path)
+	return nil
+}
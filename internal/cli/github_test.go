@@ -0,0 +1,71 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mwistrand/graft/internal/provider"
+)
+
+func TestWriteGitHubSummary(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "step_summary.md")
+	if err := os.WriteFile(path, []byte("## Earlier step\n\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv(githubSummaryEnv, path)
+
+	summary := &provider.SummarizeResponse{
+		Overview:   "Adds a login page.",
+		KeyChanges: []string{"New login form"},
+	}
+	order := &provider.OrderResponse{
+		Files: []provider.OrderedFile{{Path: "login.go", Category: provider.CategoryComponent}},
+	}
+
+	if err := writeGitHubSummary("Graft Review: feature against main", summary, order); err != nil {
+		t.Fatalf("writeGitHubSummary() failed: %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	content := string(data)
+
+	if !strings.HasPrefix(content, "## Earlier step\n\n") {
+		t.Error("existing job summary content should be preserved")
+	}
+	for _, want := range []string{
+		"## Graft Review: feature against main",
+		"Adds a login page.",
+		"- New login form",
+		"| 1 | `login.go` | component |",
+	} {
+		if !strings.Contains(content, want) {
+			t.Errorf("job summary should contain %q\n%s", want, content)
+		}
+	}
+}
+
+func TestWriteGitHubSummary_NoEnv(t *testing.T) {
+	t.Setenv(githubSummaryEnv, "")
+
+	summary := &provider.SummarizeResponse{Overview: "Adds a login page."}
+	if err := writeGitHubSummary("Graft Review", summary, nil); err != nil {
+		t.Errorf("expected no error without %s, got %v", githubSummaryEnv, err)
+	}
+}
+
+func TestWriteGitHubSummary_NothingToReport(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "step_summary.md")
+	t.Setenv(githubSummaryEnv, path)
+
+	if err := writeGitHubSummary("Graft Review", nil, nil); err != nil {
+		t.Fatalf("writeGitHubSummary() failed: %v", err)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Error("job summary file should not be created when there is nothing to report")
+	}
+}
@@ -0,0 +1,155 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/mwistrand/graft/internal/config"
+	"github.com/mwistrand/graft/internal/git"
+	"github.com/mwistrand/graft/internal/prompt"
+	"github.com/mwistrand/graft/internal/provider"
+	"github.com/mwistrand/graft/internal/provider/prompts"
+)
+
+// loadPatch reads a unified diff from path and parses it into a DiffResult.
+// Returns the parsed result along with the raw diff text.
+func loadPatch(path string) (*git.DiffResult, string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, "", fmt.Errorf("reading patch file: %w", err)
+	}
+
+	files, stats, err := git.ParsePatch(string(data))
+	if err != nil {
+		if errors.Is(err, git.ErrEmptyPatch) {
+			return nil, "", fmt.Errorf("%s is not a valid unified diff: %w", path, err)
+		}
+		return nil, "", fmt.Errorf("parsing patch file: %w", err)
+	}
+
+	return &git.DiffResult{
+		BaseRef: path,
+		Files:   files,
+		Stats:   stats,
+	}, string(data), nil
+}
+
+// runPatchReview reviews a patch file without requiring a git repository.
+// Repository analysis, response caching, and repository-specific prompt
+// overrides are skipped, since all depend on repository state.
+func runPatchReview(ctx context.Context, cfg *config.Config, path string) error {
+	diffResult, fullDiff, err := loadPatch(path)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Reviewing patch %s\n\n", path)
+	fmt.Printf("Found %d changed files (+%d/-%d)\n\n",
+		len(diffResult.Files), diffResult.Stats.Additions, diffResult.Stats.Deletions)
+
+	if aiReview {
+		fmt.Println("Note: --ai-review is not supported with --patch.")
+		fmt.Println()
+	}
+
+	// There is no repository to hold .graft/personas overrides, so only the
+	// built-in persona prompts are used
+	var personaPrompt string
+	if personaName != "" {
+		personaPrompt, err = prompts.PersonaPrompt(personaName)
+		if err != nil {
+			return fmt.Errorf("loading persona prompt: %w", err)
+		}
+	}
+
+	renderer := newRenderer(cfg)
+
+	aiProvider, cleanup := startProvider(ctx, cfg)
+	if cleanup != nil {
+		defer cleanup()
+	}
+
+	// AI Summary
+	var summary *provider.SummarizeResponse
+	if aiProvider != nil && !skipSummary {
+		Verbose("Generating AI summary...")
+		fmt.Println("Analyzing changes...")
+
+		summary, err = aiProvider.SummarizeChanges(ctx, &provider.SummarizeRequest{
+			Files:        diffResult.Files,
+			FullDiff:     fullDiff,
+			SystemPrompt: personaPrompt,
+			Options:      provider.DefaultSummarizeOptions(),
+		})
+		if err != nil {
+			fmt.Printf("Warning: Failed to generate summary: %v\n\n", err)
+			summary = nil
+		} else if err := renderer.RenderSummary(summary); err != nil {
+			return fmt.Errorf("rendering summary: %w", err)
+		}
+	}
+
+	if summary != nil {
+		if !prompt.ConfirmContinue("") {
+			fmt.Println("Review cancelled.")
+			return nil
+		}
+	}
+
+	// File ordering
+	var orderedFiles *provider.OrderResponse
+	if aiProvider != nil && !skipOrdering {
+		Verbose("Determining file review order...")
+		orderedFiles, err = aiProvider.OrderFiles(ctx, &provider.OrderRequest{
+			Files:      diffResult.Files,
+			TestsFirst: testsFirst,
+		})
+		if err != nil {
+			fmt.Printf("Warning: Failed to determine order: %v\n", err)
+			fmt.Println("Using default file order.")
+			fmt.Println()
+			orderedFiles = nil
+		} else if err := renderer.RenderOrdering(orderedFiles); err != nil {
+			return fmt.Errorf("rendering ordering: %w", err)
+		}
+	}
+
+	if githubSummary {
+		if err := writeGitHubSummary("Graft Review: "+path, summary, orderedFiles); err != nil {
+			fmt.Printf("Warning: Failed to write GitHub job summary: %v\n", err)
+		}
+	}
+
+	filesToReview := selectFilesToReview(diffResult.Files, orderedFiles)
+
+	patches := make(map[string]string, len(diffResult.Files))
+	for _, f := range diffResult.Files {
+		patches[f.Path] = f.Patch
+	}
+
+	// Display diffs
+	for i, file := range filesToReview {
+		patch, ok := patches[file.Path]
+		if !ok {
+			Verbose("Skipping %s: not found in patch", file.Path)
+			continue
+		}
+
+		if err := renderer.RenderFileHeader(&file, i+1, len(filesToReview)); err != nil {
+			return fmt.Errorf("rendering file header: %w", err)
+		}
+
+		if err := renderer.RenderPatch(ctx, file.Path, patch); err != nil {
+			fmt.Printf("Warning: Failed to render diff for %s: %v\n", file.Path, err)
+		}
+	}
+
+	if err := renderer.RenderFlaggedLines(); err != nil {
+		return fmt.Errorf("rendering flagged lines: %w", err)
+	}
+
+	fmt.Println("\nReview complete!")
+	return nil
+}
@@ -0,0 +1,104 @@
+package cli
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mwistrand/graft/internal/git"
+	"github.com/mwistrand/graft/internal/provider"
+)
+
+const samplePatch = `diff --git a/handler.go b/handler.go
+index 1111111..2222222 100644
+--- a/handler.go
++++ b/handler.go
+@@ -1,3 +1,4 @@
+ package api
+
+ func Handle() {}
++func HandleV2() {}
+diff --git a/handler_test.go b/handler_test.go
+new file mode 100644
+--- /dev/null
++++ b/handler_test.go
+@@ -0,0 +1 @@
++package api
+`
+
+func TestLoadPatch(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "changes.diff")
+	if err := os.WriteFile(path, []byte(samplePatch), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	result, fullDiff, err := loadPatch(path)
+	if err != nil {
+		t.Fatalf("loadPatch() failed: %v", err)
+	}
+
+	if fullDiff != samplePatch {
+		t.Error("full diff should be the raw patch content")
+	}
+	if len(result.Files) != 2 {
+		t.Fatalf("expected 2 files, got %d", len(result.Files))
+	}
+	if result.Files[0].Path != "handler.go" || result.Files[0].Additions != 1 {
+		t.Errorf("unexpected first file: %+v", result.Files[0])
+	}
+	if result.Files[1].Status != git.StatusAdded {
+		t.Errorf("expected second file to be added, got %q", result.Files[1].Status)
+	}
+	if len(result.Commits) != 0 {
+		t.Errorf("expected no commits for a patch, got %d", len(result.Commits))
+	}
+
+	// Without AI ordering, files keep patch order
+	files := buildFileList(result.Files, nil)
+	if files[0].Path != "handler.go" || files[1].Category != provider.CategoryTest {
+		t.Errorf("unexpected file list: %+v", files)
+	}
+}
+
+func TestLoadPatch_NotADiff(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "notes.txt")
+	if err := os.WriteFile(path, []byte("meeting notes, not a diff\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	_, _, err := loadPatch(path)
+	if err == nil {
+		t.Fatal("expected error for a file that is not a diff")
+	}
+	if !errors.Is(err, git.ErrEmptyPatch) {
+		t.Errorf("expected ErrEmptyPatch, got %v", err)
+	}
+}
+
+func TestLoadPatch_MissingFile(t *testing.T) {
+	_, _, err := loadPatch(filepath.Join(t.TempDir(), "missing.diff"))
+	if err == nil {
+		t.Fatal("expected error for a missing file")
+	}
+}
+
+func TestValidateReviewArgs(t *testing.T) {
+	defer func() { patchFile = "" }()
+
+	patchFile = ""
+	if err := validateReviewArgs(reviewCmd, nil); err == nil {
+		t.Error("expected error when neither a base branch nor --patch is given")
+	}
+	if err := validateReviewArgs(reviewCmd, []string{"main"}); err != nil {
+		t.Errorf("unexpected error for base branch: %v", err)
+	}
+
+	patchFile = "changes.diff"
+	if err := validateReviewArgs(reviewCmd, nil); err != nil {
+		t.Errorf("unexpected error for --patch: %v", err)
+	}
+	if err := validateReviewArgs(reviewCmd, []string{"main"}); err == nil {
+		t.Error("expected error when combining a base branch with --patch")
+	}
+}
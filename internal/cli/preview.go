@@ -0,0 +1,128 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/mwistrand/graft/internal/git"
+	"github.com/mwistrand/graft/internal/provider"
+	"github.com/mwistrand/graft/internal/render"
+)
+
+// displayTracker records which files have been displayed, so files shown in the
+// summary group preview are skipped once the ordering arrives.
+type displayTracker struct {
+	shown map[string]bool
+}
+
+// newDisplayTracker creates a tracker with no files displayed.
+func newDisplayTracker() *displayTracker {
+	return &displayTracker{shown: make(map[string]bool)}
+}
+
+// take returns the files in batch that have not been displayed yet, keeping
+// their order, and marks them as displayed. Repeated paths are returned once.
+func (d *displayTracker) take(batch []provider.OrderedFile) []provider.OrderedFile {
+	var pending []provider.OrderedFile
+	for _, f := range batch {
+		if d.shown[f.Path] {
+			continue
+		}
+		d.shown[f.Path] = true
+		pending = append(pending, f)
+	}
+	return pending
+}
+
+// count returns how many files have been displayed.
+func (d *displayTracker) count() int {
+	return len(d.shown)
+}
+
+// remaining returns order without the files already displayed, dropping groups
+// left with no files, so the group selector only offers files not yet seen.
+// The original order is not modified. Returns nil for a nil order.
+func (d *displayTracker) remaining(order *provider.OrderResponse) *provider.OrderResponse {
+	if order == nil {
+		return nil
+	}
+
+	result := *order
+	result.Files = nil
+	pending := make(map[string]bool)
+	for _, f := range order.Files {
+		if d.shown[f.Path] {
+			continue
+		}
+		result.Files = append(result.Files, f)
+		pending[f.Group] = true
+	}
+
+	result.Groups = nil
+	for _, g := range order.Groups {
+		if pending[g.Name] {
+			result.Groups = append(result.Groups, g)
+		}
+	}
+	return &result
+}
+
+// summaryGroupPreview returns the files of the summary's first file group, for
+// display while the ordering is still being determined. Paths that are not in the
+// diff are skipped. Tests follow implementation files unless testsFirst is set.
+// Returns nil if the summary has no file groups.
+func summaryGroupPreview(summary *provider.SummarizeResponse, files []git.FileDiff, testsFirst bool) []provider.OrderedFile {
+	if summary == nil || len(summary.FileGroups) == 0 {
+		return nil
+	}
+
+	byPath := make(map[string]git.FileDiff, len(files))
+	for _, f := range files {
+		byPath[f.Path] = f
+	}
+
+	group := summary.FileGroups[0]
+	var result []provider.OrderedFile
+	for _, path := range group.Files {
+		f, ok := byPath[path]
+		if !ok {
+			continue
+		}
+		result = append(result, provider.OrderedFile{
+			Path:        f.Path,
+			Category:    categorizeFile(f.Path),
+			Priority:    len(result) + 1,
+			Description: describeStatus(f.Status),
+			Group:       group.Name,
+		})
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		ti := result[i].Category == provider.CategoryTest
+		tj := result[j].Category == provider.CategoryTest
+		if testsFirst {
+			return ti && !tj
+		}
+		return !ti && tj
+	})
+
+	return result
+}
+
+// displayFiles renders the header and diff for each file. offset is the number
+// of files already displayed, used to number the headers; a total of 0 leaves
+// it out of the headers.
+func displayFiles(ctx context.Context, renderer render.Renderer, repoDir, baseRef string, files []provider.OrderedFile, offset, total int) error {
+	for i, file := range files {
+		if err := renderer.RenderFileHeader(&file, offset+i+1, total); err != nil {
+			return fmt.Errorf("rendering file header: %w", err)
+		}
+
+		if err := renderer.RenderFileDiff(ctx, repoDir, baseRef, file.Path, offset+i+1, total); err != nil {
+			// Non-fatal: continue with other files
+			fmt.Printf("Warning: Failed to render diff for %s: %v\n", file.Path, err)
+		}
+	}
+	return nil
+}
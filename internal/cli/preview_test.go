@@ -0,0 +1,175 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/mwistrand/graft/internal/git"
+	"github.com/mwistrand/graft/internal/provider"
+)
+
+func paths(files []provider.OrderedFile) []string {
+	result := make([]string, len(files))
+	for i, f := range files {
+		result[i] = f.Path
+	}
+	return result
+}
+
+func equalPaths(got, want []string) bool {
+	if len(got) != len(want) {
+		return false
+	}
+	for i := range got {
+		if got[i] != want[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestDisplayTracker_Take(t *testing.T) {
+	tracker := newDisplayTracker()
+
+	first := tracker.take([]provider.OrderedFile{{Path: "a.go"}, {Path: "b.go"}, {Path: "a.go"}})
+	if got := paths(first); !equalPaths(got, []string{"a.go", "b.go"}) {
+		t.Errorf("first take = %v, want [a.go b.go]", got)
+	}
+
+	second := tracker.take([]provider.OrderedFile{{Path: "c.go"}, {Path: "b.go"}, {Path: "d.go"}})
+	if got := paths(second); !equalPaths(got, []string{"c.go", "d.go"}) {
+		t.Errorf("second take = %v, want [c.go d.go]", got)
+	}
+
+	if tracker.count() != 4 {
+		t.Errorf("count() = %d, want 4", tracker.count())
+	}
+	if got := tracker.take(nil); len(got) != 0 {
+		t.Errorf("take(nil) = %v, want empty", got)
+	}
+}
+
+func TestSummaryGroupPreview(t *testing.T) {
+	files := []git.FileDiff{
+		{Path: "internal/auth/login_test.go", Status: git.StatusAdded},
+		{Path: "internal/auth/login.go", Status: git.StatusModified},
+		{Path: "internal/auth/session.go", Status: git.StatusAdded},
+		{Path: "README.md", Status: git.StatusModified},
+	}
+	summary := &provider.SummarizeResponse{
+		FileGroups: []provider.FileGroup{
+			{
+				Name:  "Authentication",
+				Files: []string{"internal/auth/login_test.go", "internal/auth/login.go", "internal/auth/missing.go", "internal/auth/session.go"},
+			},
+			{Name: "Docs", Files: []string{"README.md"}},
+		},
+	}
+
+	got := summaryGroupPreview(summary, files, false)
+	want := []string{"internal/auth/login.go", "internal/auth/session.go", "internal/auth/login_test.go"}
+	if !equalPaths(paths(got), want) {
+		t.Errorf("summaryGroupPreview() = %v, want %v", paths(got), want)
+	}
+	for _, f := range got {
+		if f.Group != "Authentication" {
+			t.Errorf("%s has group %q, want Authentication", f.Path, f.Group)
+		}
+	}
+
+	got = summaryGroupPreview(summary, files, true)
+	if len(got) == 0 || got[0].Path != "internal/auth/login_test.go" {
+		t.Errorf("with testsFirst, tests should come first: %v", paths(got))
+	}
+}
+
+func TestSummaryGroupPreview_NoGroups(t *testing.T) {
+	files := []git.FileDiff{{Path: "main.go"}}
+
+	if got := summaryGroupPreview(nil, files, false); got != nil {
+		t.Errorf("summaryGroupPreview(nil) = %v, want nil", got)
+	}
+	if got := summaryGroupPreview(&provider.SummarizeResponse{Overview: "x"}, files, false); got != nil {
+		t.Errorf("summaryGroupPreview() without groups = %v, want nil", got)
+	}
+}
+
+func TestSummaryGroupPreview_NoFileShownTwice(t *testing.T) {
+	files := []git.FileDiff{
+		{Path: "api/handler.go"},
+		{Path: "api/handler_test.go"},
+		{Path: "db/store.go"},
+		{Path: "db/store_test.go"},
+	}
+	summary := &provider.SummarizeResponse{
+		FileGroups: []provider.FileGroup{
+			{Name: "API", Files: []string{"api/handler.go", "api/handler_test.go"}},
+			{Name: "Storage", Files: []string{"db/store.go", "db/store_test.go"}},
+		},
+	}
+
+	// The first summary group is displayed while the ordering runs
+	tracker := newDisplayTracker()
+	early := tracker.take(summaryGroupPreview(summary, files, false))
+
+	// The final ordering puts storage first and still includes the API files
+	ordering := &provider.OrderResponse{
+		Files: []provider.OrderedFile{
+			{Path: "db/store.go", Priority: 1},
+			{Path: "api/handler.go", Priority: 2},
+			{Path: "db/store_test.go", Priority: 3},
+			{Path: "api/handler_test.go", Priority: 4},
+		},
+	}
+	rest := tracker.take(buildFileList(files, ordering))
+
+	if got := paths(early); !equalPaths(got, []string{"api/handler.go", "api/handler_test.go"}) {
+		t.Errorf("early display = %v", got)
+	}
+	if got := paths(rest); !equalPaths(got, []string{"db/store.go", "db/store_test.go"}) {
+		t.Errorf("remaining display = %v, want storage files in ordering order", got)
+	}
+
+	seen := make(map[string]int)
+	for _, f := range append(early, rest...) {
+		seen[f.Path]++
+	}
+	for _, f := range files {
+		if seen[f.Path] != 1 {
+			t.Errorf("%s displayed %d times, want 1", f.Path, seen[f.Path])
+		}
+	}
+}
+
+func TestDisplayTracker_Remaining(t *testing.T) {
+	tracker := newDisplayTracker()
+	tracker.take([]provider.OrderedFile{{Path: "api/handler.go"}, {Path: "api/handler_test.go"}})
+
+	ordering := &provider.OrderResponse{
+		Files: []provider.OrderedFile{
+			{Path: "api/handler.go", Group: "API"},
+			{Path: "db/store.go", Group: "Storage"},
+			{Path: "api/handler_test.go", Group: "API"},
+			{Path: "api/routes.go", Group: "Routing"},
+		},
+		Groups: []provider.OrderGroup{
+			{Name: "API", Priority: 1},
+			{Name: "Storage", Priority: 2},
+			{Name: "Routing", Priority: 3},
+		},
+	}
+
+	got := tracker.remaining(ordering)
+	if want := []string{"db/store.go", "api/routes.go"}; !equalPaths(paths(got.Files), want) {
+		t.Errorf("remaining files = %v, want %v", paths(got.Files), want)
+	}
+	if len(got.Groups) != 2 || got.Groups[0].Name != "Storage" || got.Groups[1].Name != "Routing" {
+		t.Errorf("remaining groups = %+v, want Storage and Routing", got.Groups)
+	}
+	if len(ordering.Files) != 4 || len(ordering.Groups) != 3 {
+		t.Error("remaining() should not modify the original ordering")
+	}
+
+	if tracker.remaining(nil) != nil {
+		t.Error("remaining(nil) should be nil")
+	}
+}
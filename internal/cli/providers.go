@@ -0,0 +1,199 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mwistrand/graft/internal/config"
+	"github.com/mwistrand/graft/internal/provider"
+	"github.com/mwistrand/graft/internal/provider/claude"
+	"github.com/mwistrand/graft/internal/provider/copilot"
+)
+
+// providerDef describes a built-in provider.
+type providerDef struct {
+	// name is the value accepted by --provider and the 'provider' config key.
+	name string
+
+	// defaultModel is the model used when none is selected.
+	defaultModel string
+
+	// create builds the provider for a review. The returned cleanup function may be nil.
+	create func(ctx context.Context, cfg *config.Config, model string) (provider.Provider, func(), error)
+
+	// describe builds the provider with its default model for 'graft providers'.
+	// It must not need credentials or start any process.
+	describe func() (provider.Provider, error)
+}
+
+// builtinProviders lists the providers graft supports. initProvider,
+// 'graft providers' and config validation all use it, so they always agree
+// on what --provider accepts.
+var builtinProviders = []providerDef{
+	{
+		name:         "claude",
+		defaultModel: claude.DefaultModel,
+		create:       newClaudeProvider,
+		describe: func() (provider.Provider, error) {
+			return claude.NewUnauthenticated(claude.DefaultModel), nil
+		},
+	},
+	{
+		name:         "copilot",
+		defaultModel: copilot.DefaultModel,
+		create:       newCopilotProvider,
+		describe: func() (provider.Provider, error) {
+			// The proxy is only started by EnsureProxyRunning
+			return copilot.New("", copilot.DefaultModel)
+		},
+	},
+}
+
+// providerNames returns the names of the built-in providers.
+func providerNames() []string {
+	names := make([]string, len(builtinProviders))
+	for i, def := range builtinProviders {
+		names[i] = def.name
+	}
+	return names
+}
+
+// lookupProvider returns the built-in provider with the given name.
+// An empty name selects config.DefaultProvider.
+func lookupProvider(name string) (providerDef, error) {
+	if name == "" {
+		name = config.DefaultProvider
+	}
+	for _, def := range builtinProviders {
+		if def.name == name {
+			return def, nil
+		}
+	}
+	return providerDef{}, fmt.Errorf("unknown provider %q; available: %s", name, strings.Join(providerNames(), ", "))
+}
+
+// builtinRegistry returns a registry of the built-in providers, each with its
+// default model. The providers are only described, never used for requests.
+func builtinRegistry(defaultName string) (*provider.Registry, error) {
+	registry := provider.NewRegistry(defaultName)
+	for _, def := range builtinProviders {
+		p, err := def.describe()
+		if err != nil {
+			return nil, fmt.Errorf("creating %s provider: %w", def.name, err)
+		}
+		registry.Register(p)
+	}
+	return registry, nil
+}
+
+// ProviderInfo describes a built-in provider for 'graft providers'.
+type ProviderInfo struct {
+	provider.ProviderInfo
+
+	// DefaultModel is the model used when none is selected.
+	DefaultModel string `json:"default_model"`
+}
+
+var providersJSON bool
+
+var providersCmd = &cobra.Command{
+	Use:   "providers",
+	Short: "List the supported AI providers",
+	Long: `List the built-in AI providers and their capabilities.
+
+The provider names are the values accepted by --provider and the
+'provider' configuration key. Context sizes are for each provider's
+default model.
+
+Example:
+  graft providers         Show a table of providers
+  graft providers --json  Print providers as JSON for tooling`,
+	Args: cobra.NoArgs,
+	RunE: runProviders,
+}
+
+func init() {
+	providersCmd.Flags().BoolVar(&providersJSON, "json", false, "Print providers as JSON")
+	rootCmd.AddCommand(providersCmd)
+}
+
+func runProviders(cmd *cobra.Command, args []string) error {
+	defaultName := config.DefaultProvider
+	if cfg := GetConfig(); cfg != nil && cfg.Provider != "" {
+		defaultName = cfg.Provider
+	}
+
+	infos, err := describeProviders(defaultName)
+	if err != nil {
+		return err
+	}
+	if providersJSON {
+		return writeProvidersJSON(cmd.OutOrStdout(), infos)
+	}
+	return writeProvidersTable(cmd.OutOrStdout(), infos)
+}
+
+// describeProviders returns information about each built-in provider, taking
+// capabilities from the providers themselves. No credentials are needed.
+func describeProviders(defaultName string) ([]ProviderInfo, error) {
+	registry, err := builtinRegistry(defaultName)
+	if err != nil {
+		return nil, err
+	}
+
+	var infos []ProviderInfo
+	for _, info := range registry.Describe() {
+		def, err := lookupProvider(info.Name)
+		if err != nil {
+			return nil, err
+		}
+		infos = append(infos, ProviderInfo{ProviderInfo: info, DefaultModel: def.defaultModel})
+	}
+	return infos, nil
+}
+
+// writeProvidersJSON prints provider information as an indented JSON array.
+func writeProvidersJSON(w io.Writer, infos []ProviderInfo) error {
+	data, err := json.MarshalIndent(infos, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding providers: %w", err)
+	}
+	_, err = fmt.Fprintln(w, string(data))
+	return err
+}
+
+// writeProvidersTable prints provider information as an aligned table.
+// The default provider is marked with an asterisk.
+func writeProvidersTable(w io.Writer, infos []ProviderInfo) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "PROVIDER\tDEFAULT MODEL\tSTREAMING\tMODEL LISTING\tAI REVIEW\tCONTEXT")
+	for _, info := range infos {
+		name := info.Name
+		if info.Default {
+			name += " *"
+		}
+		caps := info.Capabilities
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%dk tokens\n",
+			name, info.DefaultModel, yesNo(caps.Streaming), yesNo(caps.ModelListing), yesNo(caps.Review), caps.ContextWindow/1000)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	_, err := fmt.Fprintln(w, "\n* default provider")
+	return err
+}
+
+// yesNo formats a boolean for table output.
+func yesNo(b bool) string {
+	if b {
+		return "yes"
+	}
+	return "no"
+}
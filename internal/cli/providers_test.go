@@ -0,0 +1,172 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/mwistrand/graft/internal/config"
+	"github.com/mwistrand/graft/internal/provider"
+	"github.com/mwistrand/graft/internal/provider/claude"
+	"github.com/mwistrand/graft/internal/provider/copilot"
+)
+
+func TestLookupProvider(t *testing.T) {
+	for _, name := range []string{"claude", "copilot"} {
+		def, err := lookupProvider(name)
+		if err != nil {
+			t.Errorf("lookupProvider(%q) failed: %v", name, err)
+			continue
+		}
+		if def.name != name {
+			t.Errorf("lookupProvider(%q) returned %q", name, def.name)
+		}
+	}
+
+	def, err := lookupProvider("")
+	if err != nil || def.name != config.DefaultProvider {
+		t.Errorf("lookupProvider(\"\") = %q, %v; want the default provider", def.name, err)
+	}
+
+	_, err = lookupProvider("openai")
+	if err == nil {
+		t.Fatal("expected error for an unknown provider")
+	}
+	if !strings.Contains(err.Error(), "claude, copilot") {
+		t.Errorf("error should list the available providers: %v", err)
+	}
+}
+
+func TestBuiltinProviders_Describe(t *testing.T) {
+	for _, def := range builtinProviders {
+		p, err := def.describe()
+		if err != nil {
+			t.Errorf("%s: describe() failed: %v", def.name, err)
+			continue
+		}
+		if p.Name() != def.name {
+			t.Errorf("describe() for %q returned provider %q", def.name, p.Name())
+		}
+
+		// The capabilities must agree with the interfaces the provider implements
+		_, lists := p.(provider.ModelLister)
+		if caps := provider.CapabilitiesOf(p); caps.ModelListing != lists {
+			t.Errorf("%s: ModelListing = %v, but ModelLister implemented = %v", def.name, caps.ModelListing, lists)
+		}
+	}
+}
+
+func TestBuiltinProviders_ConfigValidate(t *testing.T) {
+	for _, name := range providerNames() {
+		cfg := &config.Config{Provider: name, AnthropicAPIKey: "sk-ant-test"}
+		if err := cfg.Validate(providerNames()); err != nil {
+			t.Errorf("Validate() rejected built-in provider %q: %v", name, err)
+		}
+	}
+
+	cfg := &config.Config{Provider: "unknown"}
+	err := cfg.Validate(providerNames())
+	if err == nil || !strings.Contains(err.Error(), strings.Join(providerNames(), ", ")) {
+		t.Errorf("Validate() = %v, want an error listing the built-in providers", err)
+	}
+}
+
+func TestDescribeProviders(t *testing.T) {
+	infos, err := describeProviders("copilot")
+	if err != nil {
+		t.Fatalf("describeProviders() failed: %v", err)
+	}
+	if len(infos) != 2 {
+		t.Fatalf("expected 2 providers, got %d", len(infos))
+	}
+
+	byName := make(map[string]ProviderInfo)
+	for _, info := range infos {
+		byName[info.Name] = info
+	}
+
+	claudeInfo, ok := byName["claude"]
+	if !ok {
+		t.Fatal("claude provider should be listed")
+	}
+	if claudeInfo.Default {
+		t.Error("claude should not be the default")
+	}
+	if claudeInfo.DefaultModel != claude.DefaultModel {
+		t.Errorf("claude default model = %q, want %q", claudeInfo.DefaultModel, claude.DefaultModel)
+	}
+	if claudeInfo.Capabilities.ModelListing {
+		t.Error("claude should not report model listing")
+	}
+	if !claudeInfo.Capabilities.Review {
+		t.Error("claude should report review support")
+	}
+	if claudeInfo.Capabilities.ContextWindow != 200000 {
+		t.Errorf("claude context window = %d, want 200000", claudeInfo.Capabilities.ContextWindow)
+	}
+
+	copilotInfo, ok := byName["copilot"]
+	if !ok {
+		t.Fatal("copilot provider should be listed")
+	}
+	if !copilotInfo.Default {
+		t.Error("copilot should be the default")
+	}
+	if !copilotInfo.Capabilities.ModelListing {
+		t.Error("copilot should report model listing")
+	}
+}
+
+func TestWriteProvidersJSON(t *testing.T) {
+	var buf bytes.Buffer
+	infos, err := describeProviders("claude")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := writeProvidersJSON(&buf, infos); err != nil {
+		t.Fatalf("writeProvidersJSON() failed: %v", err)
+	}
+
+	var decoded []map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+		t.Fatalf("output is not valid JSON: %v\n%s", err, buf.String())
+	}
+	if len(decoded) != 2 || decoded[0]["name"] != "claude" || decoded[1]["name"] != "copilot" {
+		t.Fatalf("unexpected providers: %v", decoded)
+	}
+	if decoded[1]["default_model"] != copilot.DefaultModel {
+		t.Errorf("copilot default_model = %v, want %q", decoded[1]["default_model"], copilot.DefaultModel)
+	}
+
+	caps, ok := decoded[1]["capabilities"].(map[string]any)
+	if !ok {
+		t.Fatalf("capabilities should be an object: %v", decoded[1])
+	}
+	for _, key := range []string{"streaming", "model_listing", "review", "context_window"} {
+		if _, ok := caps[key]; !ok {
+			t.Errorf("capabilities should include %q", key)
+		}
+	}
+	if caps["model_listing"] != true {
+		t.Errorf("copilot model_listing = %v, want true", caps["model_listing"])
+	}
+}
+
+func TestWriteProvidersTable(t *testing.T) {
+	var buf bytes.Buffer
+	infos, err := describeProviders("claude")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := writeProvidersTable(&buf, infos); err != nil {
+		t.Fatalf("writeProvidersTable() failed: %v", err)
+	}
+
+	output := buf.String()
+	for _, want := range []string{"PROVIDER", "DEFAULT MODEL", "STREAMING", "MODEL LISTING", "AI REVIEW", "claude *", "copilot", claude.DefaultModel, "200k tokens", "* default provider"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("table should contain %q\n%s", want, output)
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"sort"
@@ -34,10 +35,15 @@ var (
 	noAnalyze      bool
 	aiReview       bool
 	aiReviewOutput string
+	changesOnly    bool
+	patchFile      string
+	specFile       string
+	githubSummary  bool
+	personaName    string
 )
 
 var reviewCmd = &cobra.Command{
-	Use:   "review <base-branch>",
+	Use:   "review [base-branch]",
 	Short: "Review changes against a base branch",
 	Long: `Review changes between the current branch and a base branch.
 
@@ -49,15 +55,17 @@ This command:
 Example:
   graft review main         Review changes against main
   graft review origin/main  Review changes against remote main
-  graft review HEAD~5       Review the last 5 commits`,
-	Args: cobra.ExactArgs(1),
+  graft review HEAD~5       Review the last 5 commits
+  graft review --patch changes.diff  Review a patch file without a repository
+  graft review --spec review.json    Review refs and PR details from a JSON spec`,
+	Args: validateReviewArgs,
 	RunE: runReview,
 }
 
 func init() {
 	reviewCmd.Flags().BoolVar(&skipSummary, "no-summary", false, "Skip AI summary")
 	reviewCmd.Flags().BoolVar(&skipOrdering, "no-order", false, "Skip AI ordering, use default order")
-	reviewCmd.Flags().StringVar(&providerName, "provider", "", "AI provider to use (default from config)")
+	reviewCmd.Flags().StringVar(&providerName, "provider", "", "AI provider to use (default from config; see 'graft providers')")
 	reviewCmd.Flags().StringVar(&modelName, "model", "", "Model to use (default from config)")
 	reviewCmd.Flags().BoolVar(&noDelta, "no-delta", false, "Disable Delta rendering")
 	reviewCmd.Flags().BoolVar(&testsFirst, "tests-first", false, "Show test files before implementation")
@@ -65,24 +73,67 @@ func init() {
 	reviewCmd.Flags().BoolVar(&noAnalyze, "no-analyze", false, "Skip repository analysis")
 	reviewCmd.Flags().BoolVar(&aiReview, "ai-review", false, "Generate detailed AI code review")
 	reviewCmd.Flags().StringVar(&aiReviewOutput, "ai-review-output", "", "Write AI review to file instead of console")
+	reviewCmd.Flags().BoolVar(&changesOnly, "changes-only", false, "Show only changed lines, hiding unchanged context")
+	reviewCmd.Flags().StringVar(&patchFile, "patch", "", "Review a unified diff file instead of a branch")
+	reviewCmd.Flags().StringVar(&specFile, "spec", "", "Read base/head refs and PR title/description from a JSON file")
+	reviewCmd.Flags().BoolVar(&githubSummary, "github-summary", false, "Append the summary and order to the GitHub Actions job summary")
+	reviewCmd.Flags().StringVar(&personaName, "persona", "", "Reviewer persona for summary and review ("+strings.Join(prompts.Personas, ", ")+")")
 
 	rootCmd.AddCommand(reviewCmd)
 }
 
+// validateReviewArgs requires exactly one base branch, unless --patch or --spec is given.
+func validateReviewArgs(cmd *cobra.Command, args []string) error {
+	if personaName != "" && !prompts.IsPersona(personaName) {
+		return fmt.Errorf("unknown persona %q; available: %s", personaName, strings.Join(prompts.Personas, ", "))
+	}
+	if patchFile != "" && specFile != "" {
+		return fmt.Errorf("cannot combine --patch with --spec")
+	}
+	if patchFile != "" {
+		if len(args) > 0 {
+			return fmt.Errorf("cannot combine a base branch with --patch")
+		}
+		return nil
+	}
+	if specFile != "" {
+		if len(args) > 0 {
+			return fmt.Errorf("cannot combine a base branch with --spec")
+		}
+		return nil
+	}
+	return cobra.ExactArgs(1)(cmd, args)
+}
+
 func runReview(cmd *cobra.Command, args []string) error {
 	ctx := cmd.Context()
 	if ctx == nil {
 		ctx = context.Background()
 	}
 
-	baseRef := args[0]
-
 	// Get config
 	cfg := GetConfig()
 	if cfg == nil {
 		return fmt.Errorf("configuration not loaded")
 	}
 
+	if patchFile != "" {
+		return runPatchReview(ctx, cfg, patchFile)
+	}
+
+	var spec *ReviewSpec
+	var baseRef string
+	if specFile != "" {
+		loaded, err := loadReviewSpec(specFile)
+		if err != nil {
+			return err
+		}
+		spec = loaded
+		baseRef = spec.Base
+	} else {
+		baseRef = args[0]
+	}
+
 	// Create git repository
 	Verbose("Opening git repository...")
 	repo, err := git.NewRepository("")
@@ -98,6 +149,13 @@ func runReview(cmd *cobra.Command, args []string) error {
 	if err := repo.ValidateBranch(ctx, baseRef); err != nil {
 		return err
 	}
+	baseRef = checkStaleBase(ctx, repo, baseRef, os.Stdout)
+
+	if spec != nil {
+		if err := spec.checkHead(ctx, repo); err != nil {
+			return err
+		}
+	}
 
 	// Get current branch for display
 	currentBranch, err := repo.GetCurrentBranch(ctx)
@@ -128,6 +186,11 @@ func runReview(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("getting repo root: %w", err)
 	}
 
+	personaPrompt, err := loadPersonaPrompt(repoDir, personaName)
+	if err != nil {
+		return fmt.Errorf("loading persona prompt: %w", err)
+	}
+
 	// Repository analysis for smarter ordering
 	var repoContext string
 	if !noAnalyze && !skipOrdering {
@@ -137,32 +200,16 @@ func runReview(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	// Create renderer
-	renderOpts := render.DefaultOptions()
-	renderOpts.UseDelta = !noDelta && render.IsDeltaAvailable()
-	if !renderOpts.UseDelta && !noDelta {
-		fmt.Println("Note: Delta not found, using basic diff rendering.")
-		fmt.Println("Install Delta for better rendering: https://github.com/dandavison/delta")
-		fmt.Println()
-	}
-	renderer := render.New(renderOpts)
+	renderer := newRenderer(cfg)
 
 	// Initialize AI provider if needed
-	var aiProvider provider.Provider
-	var cleanup func()
-	if !skipSummary || !skipOrdering {
-		Verbose("Initializing AI provider...")
-		aiProvider, cleanup, err = initProvider(ctx, cfg)
-		if err != nil {
-			fmt.Printf("Warning: %v\n", err)
-			fmt.Println("Skipping AI analysis. Use --no-summary --no-order to suppress this warning.")
-			fmt.Println()
-			skipSummary = true
-			skipOrdering = true
-		}
-		if cleanup != nil {
-			defer cleanup()
-		}
+	aiProvider, cleanup := startProvider(ctx, cfg)
+	if cleanup != nil {
+		defer cleanup()
+	}
+	if aiProvider == nil {
+		skipSummary = true
+		skipOrdering = true
 	}
 
 	// Set up review cache
@@ -179,6 +226,17 @@ func runReview(cmd *cobra.Command, args []string) error {
 		if cachedReview != nil {
 			Verbose("Using cached AI review (key: %s)", cacheKey)
 		}
+		if cachedReview != nil && cachedReview.Persona != personaName {
+			// Summaries and reviews depend on the persona; the ordering does not
+			Verbose("Cached summary and review used a different persona; regenerating")
+			cachedReview.Summary = nil
+			cachedReview.Review = nil
+		}
+		if cachedReview != nil && cachedReview.PullRequestHash != spec.pullRequestHash() {
+			// The spec's title and description are part of the summary prompt
+			Verbose("Cached summary used different pull request metadata; regenerating")
+			cachedReview.Summary = nil
+		}
 	}
 
 	// Get full diff for AI analysis (only if needed)
@@ -236,12 +294,16 @@ func runReview(cmd *cobra.Command, args []string) error {
 			Verbose("Generating AI summary...")
 			fmt.Println("Analyzing changes...")
 
-			summary, err = aiProvider.SummarizeChanges(ctx, &provider.SummarizeRequest{
+			summaryReq := &provider.SummarizeRequest{
 				Files:    diffResult.Files,
 				Commits:  diffResult.Commits,
 				FullDiff: fullDiff,
 				Options:  provider.DefaultSummarizeOptions(),
-			})
+			}
+			spec.applyTo(summaryReq)
+			summaryReq.SystemPrompt = personaPrompt
+
+			summary, err = aiProvider.SummarizeChanges(ctx, summaryReq)
 			if err != nil {
 				fmt.Printf("Warning: Failed to generate summary: %v\n\n", err)
 			} else {
@@ -273,10 +335,13 @@ func runReview(cmd *cobra.Command, args []string) error {
 				}
 			}
 
-			// Load system prompt (uses .graft/code-reviewer.md override or embedded default)
-			systemPrompt, err := loadReviewPrompt(repoDir)
-			if err != nil {
-				return fmt.Errorf("loading review prompt: %w", err)
+			// Load system prompt (uses the persona, .graft/code-reviewer.md override, or embedded default)
+			systemPrompt := personaPrompt
+			if systemPrompt == "" {
+				systemPrompt, err = loadReviewPrompt(repoDir)
+				if err != nil {
+					return fmt.Errorf("loading review prompt: %w", err)
+				}
 			}
 
 			Verbose("Generating AI code review...")
@@ -314,10 +379,26 @@ func runReview(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	// Wait for ordering to complete
+	// If the ordering is still running, preview the summary's first group while
+	// it finishes. The files selected after it are not known yet, so the
+	// preview headers leave out the total.
+	tracker := newDisplayTracker()
+	var result orderResult
+	select {
+	case result = <-orderCh:
+	default:
+		if preview := tracker.take(summaryGroupPreview(summary, diffResult.Files, testsFirst)); len(preview) > 0 {
+			fmt.Printf("Previewing %q while the review order is determined...\n", summary.FileGroups[0].Name)
+			if err := displayFiles(ctx, renderer, repoDir, baseRef, preview, 0, 0); err != nil {
+				return err
+			}
+			fmt.Println()
+		}
+		result = <-orderCh
+	}
+
 	var orderedFiles *provider.OrderResponse
 	var orderingFromCache bool
-	result := <-orderCh
 	if result.err != nil {
 		fmt.Printf("Warning: Failed to determine order: %v\n", result.err)
 		fmt.Println("Using default file order.")
@@ -328,8 +409,11 @@ func runReview(cmd *cobra.Command, args []string) error {
 		if cachedReview != nil && cachedReview.Ordering != nil {
 			orderingFromCache = true
 		}
-		if err := renderer.RenderOrdering(orderedFiles); err != nil {
-			return fmt.Errorf("rendering ordering: %w", err)
+		// Only the files still to come are listed after a preview
+		if remaining := tracker.remaining(orderedFiles); len(remaining.Files) > 0 {
+			if err := renderer.RenderOrdering(remaining); err != nil {
+				return fmt.Errorf("rendering ordering: %w", err)
+			}
 		}
 	}
 
@@ -342,8 +426,10 @@ func runReview(cmd *cobra.Command, args []string) error {
 		}
 
 		newCache := &provider.CachedReview{
-			CacheKey: cacheKey,
-			BaseRef:  baseRef,
+			CacheKey:        cacheKey,
+			BaseRef:         baseRef,
+			Persona:         personaName,
+			PullRequestHash: spec.pullRequestHash(),
 			CommitHashes: func() []string {
 				hashes := make([]string, len(diffResult.Commits))
 				for i, c := range diffResult.Commits {
@@ -363,38 +449,102 @@ func runReview(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	// Build file list for display
-	var filesToReview []provider.OrderedFile
-
-	// If we have groups, let user select which to review
-	if orderedFiles != nil && len(orderedFiles.Groups) > 0 {
-		selectedGroups, err := promptGroupSelection(orderedFiles.Groups, orderedFiles.Files)
-		if err != nil {
-			fmt.Printf("Warning: Group selection failed: %v\n", err)
-			filesToReview = buildFileList(diffResult.Files, orderedFiles)
-		} else {
-			filesToReview = buildGroupedFileList(orderedFiles.Files, selectedGroups)
+	if githubSummary {
+		title := fmt.Sprintf("Graft Review: %s against %s", currentBranch, baseRef)
+		if err := writeGitHubSummary(title, summary, orderedFiles); err != nil {
+			fmt.Printf("Warning: Failed to write GitHub job summary: %v\n", err)
 		}
-	} else {
-		filesToReview = buildFileList(diffResult.Files, orderedFiles)
 	}
 
+	// Build file list for display, leaving files that were already shown out
+	// of the group selection
+	shown := tracker.count()
+	filesToReview := tracker.take(selectFilesToReview(diffResult.Files, tracker.remaining(orderedFiles)))
+	total := shown + len(filesToReview)
+
 	// Display diffs
-	for i, file := range filesToReview {
-		if err := renderer.RenderFileHeader(&file, i+1, len(filesToReview)); err != nil {
-			return fmt.Errorf("rendering file header: %w", err)
-		}
+	if err := displayFiles(ctx, renderer, repoDir, baseRef, filesToReview, shown, total); err != nil {
+		return err
+	}
 
-		if err := renderer.RenderFileDiff(ctx, repoDir, baseRef, file.Path, i+1, len(filesToReview)); err != nil {
-			// Non-fatal: continue with other files
-			fmt.Printf("Warning: Failed to render diff for %s: %v\n", file.Path, err)
-		}
+	if err := renderer.RenderFlaggedLines(); err != nil {
+		return fmt.Errorf("rendering flagged lines: %w", err)
 	}
 
 	fmt.Println("\nReview complete!")
 	return nil
 }
 
+// branchInspector is the part of git.Repository used by checkStaleBase.
+type branchInspector interface {
+	IsLocalBranch(ctx context.Context, name string) bool
+	GetBranchInfo(ctx context.Context, branch string) (*git.BranchInfo, error)
+}
+
+// checkStaleBase warns when baseRef is a local branch far behind its upstream,
+// as with a 'main' that has not been pulled in a while. Reviewing against it
+// would include changes that are already upstream. In an interactive terminal
+// the user is offered the upstream ref instead. Refs that are not local
+// branches, such as HEAD or origin/main, are returned unchanged.
+// Returns the ref to review against.
+func checkStaleBase(ctx context.Context, repo branchInspector, baseRef string, w io.Writer) string {
+	if !repo.IsLocalBranch(ctx, baseRef) {
+		return baseRef
+	}
+
+	info, err := repo.GetBranchInfo(ctx, baseRef)
+	if err != nil || !info.IsStale() {
+		return baseRef
+	}
+
+	if prompt.IsInteractive() {
+		question := fmt.Sprintf("%s is %d commits behind %s. Review against %s instead?",
+			baseRef, info.BehindBy, info.Upstream, info.Upstream)
+		if useUpstream, err := prompt.Confirm(question); err == nil && useUpstream {
+			return info.Upstream
+		}
+		return baseRef
+	}
+
+	fmt.Fprintf(w, "Warning: %s is %d commits behind %s; did you mean %s?\n\n",
+		baseRef, info.BehindBy, info.Upstream, info.Upstream)
+	return baseRef
+}
+
+// newRenderer creates the diff renderer from flags and configuration.
+// Prints a note when Delta was wanted but is not installed.
+func newRenderer(cfg *config.Config) render.Renderer {
+	renderOpts := render.DefaultOptions()
+	renderOpts.UseDelta = !noDelta && render.IsDeltaAvailable()
+	renderOpts.ChangesOnly = changesOnly
+	renderOpts.ConcernKeywords = cfg.Keywords()
+	if !renderOpts.UseDelta && !noDelta {
+		fmt.Println("Note: Delta not found, using basic diff rendering.")
+		fmt.Println("Install Delta for better rendering: https://github.com/dandavison/delta")
+		fmt.Println()
+	}
+	return render.New(renderOpts)
+}
+
+// startProvider initializes the AI provider when a summary or ordering is requested.
+// On failure it prints a warning and returns a nil provider so the review can
+// continue without AI. The returned cleanup function may be nil.
+func startProvider(ctx context.Context, cfg *config.Config) (provider.Provider, func()) {
+	if skipSummary && skipOrdering {
+		return nil, nil
+	}
+
+	Verbose("Initializing AI provider...")
+	aiProvider, cleanup, err := initProvider(ctx, cfg)
+	if err != nil {
+		fmt.Printf("Warning: %v\n", err)
+		fmt.Println("Skipping AI analysis. Use --no-summary --no-order to suppress this warning.")
+		fmt.Println()
+		return nil, cleanup
+	}
+	return aiProvider, cleanup
+}
+
 // initProvider creates an AI provider based on configuration.
 // Returns a cleanup function that should be called when done (may be nil).
 func initProvider(ctx context.Context, cfg *config.Config) (provider.Provider, func(), error) {
@@ -408,59 +558,72 @@ func initProvider(ctx context.Context, cfg *config.Config) (provider.Provider, f
 		model = cfg.Model
 	}
 
-	switch pName {
-	case "claude", "":
-		apiKey := cfg.AnthropicAPIKey
-		if apiKey == "" {
-			return nil, nil, fmt.Errorf("Anthropic API key not set. Run 'graft config set anthropic-api-key <key>' or set ANTHROPIC_API_KEY")
-		}
-		p, err := claude.New(apiKey, model)
-		return p, nil, err
+	def, err := lookupProvider(pName)
+	if err != nil {
+		return nil, nil, err
+	}
 
-	case "copilot":
-		baseURL := cfg.CopilotBaseURL
-		copilotModel := modelName
-		p, err := copilot.New(baseURL, copilotModel)
-		if err != nil {
-			return nil, nil, err
-		}
+	provider.SetTokenLimits("", provider.TokenLimits{
+		Summary: cfg.SummaryMaxTokens,
+		Order:   cfg.OrderMaxTokens,
+		Review:  cfg.ReviewMaxTokens,
+	})
+	return def.create(ctx, cfg, model)
+}
 
-		// Ensure the copilot-api proxy is running
-		started, err := p.EnsureProxyRunning(ctx, func(format string, args ...any) {
-			fmt.Printf(format+"\n", args...)
-		})
-		if err != nil {
-			return nil, nil, fmt.Errorf("copilot proxy: %w", err)
-		}
+// newClaudeProvider creates the claude provider from the configured API key.
+func newClaudeProvider(ctx context.Context, cfg *config.Config, model string) (provider.Provider, func(), error) {
+	apiKey := cfg.AnthropicAPIKey
+	if apiKey == "" {
+		return nil, nil, fmt.Errorf("Anthropic API key not set. Run 'graft config set anthropic-api-key <key>' or set ANTHROPIC_API_KEY")
+	}
+	p, err := claude.New(apiKey, model)
+	return p, nil, err
+}
 
-		// Return cleanup function if we started the proxy
-		var cleanup func()
-		if started {
-			cleanup = func() {
-				fmt.Println("Stopping copilot-api proxy...")
-				p.Close()
-			}
-		}
+// newCopilotProvider creates the copilot provider, starting the copilot-api proxy
+// if needed. Only --model skips the interactive model selection; the configured
+// model is not used.
+func newCopilotProvider(ctx context.Context, cfg *config.Config, model string) (provider.Provider, func(), error) {
+	baseURL := cfg.CopilotBaseURL
+	copilotModel := modelName
+	p, err := copilot.New(baseURL, copilotModel)
+	if err != nil {
+		return nil, nil, err
+	}
 
-		// Prompt for model selection if no --model flag was provided
-		if modelName == "" {
-			selected, err := promptForModel(ctx, p)
-			if err != nil {
-				// On error, fall back to default model and inform the user
-				fmt.Printf("Note: %v\n", err)
-				p.SetModel(copilot.DefaultModel)
-				fmt.Printf("Using default model: %s\n\n", p.Model())
-			} else if selected != "" {
-				p.SetModel(selected)
-				fmt.Printf("Using model: %s\n\n", selected)
-			}
-		}
+	// Ensure the copilot-api proxy is running
+	started, err := p.EnsureProxyRunning(ctx, func(format string, args ...any) {
+		fmt.Printf(format+"\n", args...)
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("copilot proxy: %w", err)
+	}
 
-		return p, cleanup, nil
+	// Return cleanup function if we started the proxy
+	var cleanup func()
+	if started {
+		cleanup = func() {
+			fmt.Println("Stopping copilot-api proxy...")
+			p.Close()
+		}
+	}
 
-	default:
-		return nil, nil, fmt.Errorf("unknown provider %q; available: claude, copilot", pName)
+	// Prompt for model selection if no --model flag was provided
+	if modelName == "" {
+		selected, err := promptForModel(ctx, p)
+		if err != nil {
+			// On error, fall back to default model and inform the user
+			fmt.Printf("Note: %v\n", err)
+			p.SetModel(copilot.DefaultModel)
+			fmt.Printf("Using default model: %s\n\n", p.Model())
+		} else if selected != "" {
+			p.SetModel(selected)
+			fmt.Printf("Using model: %s\n\n", selected)
+		}
 	}
+
+	return p, cleanup, nil
 }
 
 // buildFileList creates the ordered list of files to review.
@@ -483,6 +646,21 @@ func buildFileList(files []git.FileDiff, aiOrder *provider.OrderResponse) []prov
 	return result
 }
 
+// selectFilesToReview builds the list of files to display.
+// If the ordering has groups, the user picks which groups to review.
+func selectFilesToReview(files []git.FileDiff, orderedFiles *provider.OrderResponse) []provider.OrderedFile {
+	if orderedFiles == nil || len(orderedFiles.Groups) == 0 {
+		return buildFileList(files, orderedFiles)
+	}
+
+	selectedGroups, err := promptGroupSelection(orderedFiles.Groups, orderedFiles.Files)
+	if err != nil {
+		fmt.Printf("Warning: Group selection failed: %v\n", err)
+		return buildFileList(files, orderedFiles)
+	}
+	return buildGroupedFileList(orderedFiles.Files, selectedGroups)
+}
+
 // promptGroupSelection presents an interactive menu for group selection.
 // Returns the groups in the order the user wants to review them.
 func promptGroupSelection(groups []provider.OrderGroup, files []provider.OrderedFile) ([]provider.OrderGroup, error) {
@@ -703,6 +881,27 @@ func loadReviewPrompt(repoDir string) (string, error) {
 	return prompts.DefaultCodeReviewerPrompt, nil
 }
 
+// loadPersonaPrompt loads the system prompt for a review persona.
+// A .graft/personas/<persona>.md file in the repository overrides the embedded prompt.
+// Returns an empty string if persona is empty.
+func loadPersonaPrompt(repoDir, persona string) (string, error) {
+	if persona == "" {
+		return "", nil
+	}
+
+	overridePath := filepath.Join(repoDir, ".graft", "personas", persona+".md")
+	data, err := os.ReadFile(overridePath)
+	if err == nil {
+		Verbose("Using custom %s persona prompt from %s", persona, overridePath)
+		return string(data), nil
+	}
+	if !os.IsNotExist(err) {
+		return "", fmt.Errorf("reading persona prompt override: %w", err)
+	}
+
+	return prompts.PersonaPrompt(persona)
+}
+
 // outputAIReview writes the AI review to console or a file.
 func outputAIReview(content string, outputPath string) error {
 	if content == "" {
@@ -1,7 +1,11 @@
 package cli
 
 import (
+	"bytes"
+	"context"
+	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -380,6 +384,117 @@ func TestLoadReviewPrompt(t *testing.T) {
 	})
 }
 
+func TestLoadPersonaPrompt(t *testing.T) {
+	t.Run("no persona", func(t *testing.T) {
+		content, err := loadPersonaPrompt(t.TempDir(), "")
+		if err != nil {
+			t.Fatalf("loadPersonaPrompt() failed: %v", err)
+		}
+		if content != "" {
+			t.Errorf("content = %q, want empty", content)
+		}
+	})
+
+	t.Run("override file exists", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		personaDir := filepath.Join(tmpDir, ".graft", "personas")
+		if err := os.MkdirAll(personaDir, 0755); err != nil {
+			t.Fatal(err)
+		}
+		expected := "You are our in-house security team."
+		if err := os.WriteFile(filepath.Join(personaDir, "security-auditor.md"), []byte(expected), 0644); err != nil {
+			t.Fatal(err)
+		}
+
+		content, err := loadPersonaPrompt(tmpDir, "security-auditor")
+		if err != nil {
+			t.Fatalf("loadPersonaPrompt() failed: %v", err)
+		}
+		if content != expected {
+			t.Errorf("content = %q, want %q", content, expected)
+		}
+
+		// Other personas still use the embedded prompt
+		content, err = loadPersonaPrompt(tmpDir, "performance-tuner")
+		if err != nil {
+			t.Fatalf("loadPersonaPrompt() failed: %v", err)
+		}
+		if content == expected {
+			t.Error("override should only apply to its own persona")
+		}
+	})
+}
+
+func TestValidateReviewArgs_Persona(t *testing.T) {
+	defer func() { personaName = "" }()
+
+	personaName = "security-auditor"
+	if err := validateReviewArgs(reviewCmd, []string{"main"}); err != nil {
+		t.Errorf("unexpected error for known persona: %v", err)
+	}
+
+	personaName = "pirate"
+	if err := validateReviewArgs(reviewCmd, []string{"main"}); err == nil {
+		t.Error("expected error for unknown persona")
+	}
+}
+
+// fakeBranches reports fixed branch information for checkStaleBase.
+type fakeBranches struct {
+	local map[string]*git.BranchInfo
+}
+
+func (f *fakeBranches) IsLocalBranch(ctx context.Context, name string) bool {
+	_, ok := f.local[name]
+	return ok
+}
+
+func (f *fakeBranches) GetBranchInfo(ctx context.Context, branch string) (*git.BranchInfo, error) {
+	if info, ok := f.local[branch]; ok {
+		return info, nil
+	}
+	// Like git, resolve anything else to the current branch's upstream
+	return &git.BranchInfo{Name: branch, Upstream: "origin/feature", BehindBy: 50}, nil
+}
+
+func TestCheckStaleBase_WarnsWhenFarBehind(t *testing.T) {
+	repo := &fakeBranches{local: map[string]*git.BranchInfo{
+		"main": {Name: "main", Upstream: "origin/main", BehindBy: git.StaleThreshold + 5},
+	}}
+
+	var out bytes.Buffer
+	got := checkStaleBase(context.Background(), repo, "main", &out)
+
+	// Tests are not interactive, so the ref is kept and a suggestion is printed
+	if got != "main" {
+		t.Errorf("checkStaleBase() = %q, want main", got)
+	}
+	want := fmt.Sprintf("main is %d commits behind origin/main; did you mean origin/main?", git.StaleThreshold+5)
+	if !strings.Contains(out.String(), want) {
+		t.Errorf("output = %q, want it to contain %q", out.String(), want)
+	}
+}
+
+func TestCheckStaleBase_NoWarning(t *testing.T) {
+	repo := &fakeBranches{local: map[string]*git.BranchInfo{
+		"main":    {Name: "main", Upstream: "origin/main", BehindBy: 1},
+		"develop": {Name: "develop"},
+	}}
+	ctx := context.Background()
+
+	// HEAD and remote refs are not local branches, so the current
+	// branch's upstream is never suggested
+	for _, ref := range []string{"main", "develop", "origin/main", "HEAD", "HEAD~3"} {
+		var out bytes.Buffer
+		if got := checkStaleBase(ctx, repo, ref, &out); got != ref {
+			t.Errorf("checkStaleBase(%q) = %q, want it unchanged", ref, got)
+		}
+		if out.Len() != 0 {
+			t.Errorf("checkStaleBase(%q) printed %q, want no output", ref, out.String())
+		}
+	}
+}
+
 func TestOutputAIReview_ToFile(t *testing.T) {
 	tmpDir := t.TempDir()
 	outputPath := tmpDir + "/review.md"
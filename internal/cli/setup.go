@@ -0,0 +1,200 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mwistrand/graft/internal/config"
+	"github.com/mwistrand/graft/internal/prompt"
+	"github.com/mwistrand/graft/internal/provider/claude"
+	"github.com/mwistrand/graft/internal/provider/copilot"
+)
+
+var configSetupCmd = &cobra.Command{
+	Use:   "setup",
+	Short: "Interactively configure graft",
+	Long: `Walk through first-time configuration interactively.
+
+Asks which AI provider to use, collects the API key or proxy URL,
+optionally verifies it, and saves the configuration file.`,
+	Args: cobra.NoArgs,
+	RunE: runConfigSetup,
+}
+
+func init() {
+	configCmd.AddCommand(configSetupCmd)
+}
+
+// setupWizard holds the interactive steps of config setup.
+// Each step is a function so tests can script the answers.
+type setupWizard struct {
+	selectProvider func(providers []string) (string, error)
+	input          func(title, description string, secret bool) (string, error)
+	confirm        func(title string) (bool, error)
+	verifyClaude   func(ctx context.Context, apiKey string) error
+	verifyCopilot  func(ctx context.Context, baseURL string) error
+}
+
+// newSetupWizard returns a wizard backed by the interactive terminal prompts.
+func newSetupWizard() *setupWizard {
+	return &setupWizard{
+		selectProvider: prompt.SelectProvider,
+		input:          prompt.Input,
+		confirm:        prompt.Confirm,
+		verifyClaude:   verifyClaudeKey,
+		verifyCopilot:  verifyCopilotProxy,
+	}
+}
+
+func runConfigSetup(cmd *cobra.Command, args []string) error {
+	if !prompt.IsInteractive() {
+		return fmt.Errorf("config setup requires an interactive terminal; use 'graft config set <key> <value>' instead")
+	}
+
+	ctx := cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	return runSetup(ctx, newSetupWizard())
+}
+
+// runSetup loads the current configuration, walks through the wizard, and saves the result.
+func runSetup(ctx context.Context, w *setupWizard) error {
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("loading config: %w", err)
+	}
+
+	save, err := w.run(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	if !save {
+		fmt.Println("Setup cancelled. Configuration was not changed.")
+		return nil
+	}
+
+	if err := cfg.Save(); err != nil {
+		return fmt.Errorf("saving config: %w", err)
+	}
+
+	path, _ := config.ConfigPath()
+	fmt.Printf("Configuration saved to %s\n", path)
+	return nil
+}
+
+// run asks the setup questions and applies the answers to cfg.
+// Returns false if the user chose not to save after a failed verification.
+func (w *setupWizard) run(ctx context.Context, cfg *config.Config) (bool, error) {
+	providerName, err := w.selectProvider(providerNames())
+	if err != nil {
+		return false, err
+	}
+	if providerName != cfg.Provider {
+		// Models are provider specific; the new provider picks its own default
+		cfg.Model = ""
+	}
+	cfg.Provider = providerName
+
+	var verifyErr error
+	switch providerName {
+	case "claude":
+		description := "Get a key at https://console.anthropic.com/"
+		if cfg.AnthropicAPIKey != "" {
+			description = "Leave blank to keep the current key"
+		}
+		apiKey, err := w.input("Anthropic API key", description, true)
+		if err != nil {
+			return false, err
+		}
+		if apiKey != "" {
+			cfg.AnthropicAPIKey = apiKey
+		}
+		if cfg.AnthropicAPIKey == "" {
+			return false, fmt.Errorf("an Anthropic API key is required for the claude provider")
+		}
+
+		verify, err := w.confirm("Test the API key now?")
+		if err != nil {
+			return false, err
+		}
+		if verify {
+			fmt.Println("Verifying API key...")
+			if verifyErr = w.verifyClaude(ctx, cfg.AnthropicAPIKey); verifyErr == nil {
+				fmt.Println("API key verified.")
+			}
+		}
+
+	case "copilot":
+		description := fmt.Sprintf("Leave blank to use %s", config.DefaultCopilotBaseURL)
+		if cfg.CopilotBaseURL != "" {
+			description = fmt.Sprintf("Leave blank to keep %s", cfg.CopilotBaseURL)
+		}
+		baseURL, err := w.input("copilot-api proxy URL", description, false)
+		if err != nil {
+			return false, err
+		}
+		if baseURL != "" {
+			cfg.CopilotBaseURL = baseURL
+		}
+
+		verify, err := w.confirm("Verify the copilot-api proxy now? (starts it if needed)")
+		if err != nil {
+			return false, err
+		}
+		if verify {
+			if verifyErr = w.verifyCopilot(ctx, cfg.CopilotBaseURL); verifyErr == nil {
+				fmt.Println("Copilot proxy verified.")
+			}
+		}
+
+	default:
+		return false, fmt.Errorf("unknown provider %q; available: %s", providerName, strings.Join(providerNames(), ", "))
+	}
+
+	if verifyErr != nil {
+		fmt.Printf("Warning: verification failed: %v\n", verifyErr)
+		return w.confirm("Save configuration anyway?")
+	}
+
+	return true, nil
+}
+
+// verifyClaudeKey checks that the Anthropic API accepts the key.
+func verifyClaudeKey(ctx context.Context, apiKey string) error {
+	p, err := claude.New(apiKey, "")
+	if err != nil {
+		return err
+	}
+	return p.Verify(ctx)
+}
+
+// verifyCopilotProxy checks that the copilot-api proxy responds with available models.
+// The proxy is started if needed and stopped again afterwards.
+func verifyCopilotProxy(ctx context.Context, baseURL string) error {
+	p, err := copilot.New(baseURL, "")
+	if err != nil {
+		return err
+	}
+
+	started, err := p.EnsureProxyRunning(ctx, func(format string, args ...any) {
+		fmt.Printf(format+"\n", args...)
+	})
+	if err != nil {
+		return err
+	}
+	if started {
+		defer p.Close()
+	}
+
+	models, err := p.ListModels(ctx)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Found %d available models.\n", len(models))
+	return nil
+}
@@ -0,0 +1,188 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/mwistrand/graft/internal/config"
+	"github.com/mwistrand/graft/internal/prompt"
+)
+
+// scriptedWizard returns a setup wizard that answers from fixed values.
+func scriptedWizard(providerName string, inputs []string, confirms []bool) *setupWizard {
+	return &setupWizard{
+		selectProvider: func(providers []string) (string, error) {
+			return providerName, nil
+		},
+		input: func(title, description string, secret bool) (string, error) {
+			if len(inputs) == 0 {
+				return "", errors.New("unexpected input prompt: " + title)
+			}
+			answer := inputs[0]
+			inputs = inputs[1:]
+			return answer, nil
+		},
+		confirm: func(title string) (bool, error) {
+			if len(confirms) == 0 {
+				return false, errors.New("unexpected confirm prompt: " + title)
+			}
+			answer := confirms[0]
+			confirms = confirms[1:]
+			return answer, nil
+		},
+		verifyClaude: func(ctx context.Context, apiKey string) error {
+			return nil
+		},
+		verifyCopilot: func(ctx context.Context, baseURL string) error {
+			return nil
+		},
+	}
+}
+
+// isolateConfig points config loading at an empty temporary home directory.
+func isolateConfig(t *testing.T) {
+	t.Helper()
+	t.Setenv("HOME", t.TempDir())
+	for _, v := range []string{"GRAFT_PROVIDER", "GRAFT_MODEL", "ANTHROPIC_API_KEY", "OPENAI_API_KEY", "COPILOT_BASE_URL"} {
+		t.Setenv(v, "")
+	}
+}
+
+func TestRunConfigSetup_NonInteractive(t *testing.T) {
+	if prompt.IsInteractive() {
+		t.Skip("skipping: stdin is a terminal in this test environment")
+	}
+
+	err := runConfigSetup(configSetupCmd, nil)
+	if err == nil {
+		t.Fatal("expected error when not running in an interactive terminal")
+	}
+	if !strings.Contains(err.Error(), "graft config set") {
+		t.Errorf("error should point to 'graft config set': %v", err)
+	}
+}
+
+func TestRunSetup_Claude(t *testing.T) {
+	isolateConfig(t)
+
+	var verifiedKey string
+	w := scriptedWizard("claude", []string{"sk-ant-scripted"}, []bool{true})
+	w.verifyClaude = func(ctx context.Context, apiKey string) error {
+		verifiedKey = apiKey
+		return nil
+	}
+
+	if err := runSetup(context.Background(), w); err != nil {
+		t.Fatalf("runSetup() failed: %v", err)
+	}
+
+	if verifiedKey != "sk-ant-scripted" {
+		t.Errorf("verified key = %q, want %q", verifiedKey, "sk-ant-scripted")
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		t.Fatalf("Load() failed: %v", err)
+	}
+	if cfg.Provider != "claude" {
+		t.Errorf("Provider = %q, want %q", cfg.Provider, "claude")
+	}
+	if cfg.AnthropicAPIKey != "sk-ant-scripted" {
+		t.Errorf("AnthropicAPIKey = %q, want %q", cfg.AnthropicAPIKey, "sk-ant-scripted")
+	}
+}
+
+func TestRunSetup_Copilot(t *testing.T) {
+	isolateConfig(t)
+
+	w := scriptedWizard("copilot", []string{"http://localhost:5000"}, []bool{false})
+	w.verifyCopilot = func(ctx context.Context, baseURL string) error {
+		t.Error("proxy should not be verified when declined")
+		return nil
+	}
+
+	if err := runSetup(context.Background(), w); err != nil {
+		t.Fatalf("runSetup() failed: %v", err)
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		t.Fatalf("Load() failed: %v", err)
+	}
+	if cfg.Provider != "copilot" {
+		t.Errorf("Provider = %q, want %q", cfg.Provider, "copilot")
+	}
+	if cfg.CopilotBaseURL != "http://localhost:5000" {
+		t.Errorf("CopilotBaseURL = %q, want %q", cfg.CopilotBaseURL, "http://localhost:5000")
+	}
+}
+
+func TestRunSetup_CopilotKeepsExistingURL(t *testing.T) {
+	cfg := &config.Config{CopilotBaseURL: "http://proxy.internal:4141"}
+
+	w := scriptedWizard("copilot", []string{""}, []bool{false})
+	if _, err := w.run(context.Background(), cfg); err != nil {
+		t.Fatalf("run() failed: %v", err)
+	}
+
+	if cfg.CopilotBaseURL != "http://proxy.internal:4141" {
+		t.Errorf("CopilotBaseURL = %q, want the existing URL kept", cfg.CopilotBaseURL)
+	}
+}
+
+func TestRunSetup_ProviderChangeClearsModel(t *testing.T) {
+	cfg := &config.Config{Provider: "copilot", Model: "gpt-4o"}
+
+	w := scriptedWizard("claude", []string{"sk-ant-scripted"}, []bool{false})
+	if _, err := w.run(context.Background(), cfg); err != nil {
+		t.Fatalf("run() failed: %v", err)
+	}
+	if cfg.Model != "" {
+		t.Errorf("Model = %q, want it cleared after switching provider", cfg.Model)
+	}
+
+	// Keeping the same provider keeps the model
+	cfg = &config.Config{Provider: "claude", Model: "claude-opus-4-20250514", AnthropicAPIKey: "sk-ant-existing"}
+	w = scriptedWizard("claude", []string{""}, []bool{false})
+	if _, err := w.run(context.Background(), cfg); err != nil {
+		t.Fatalf("run() failed: %v", err)
+	}
+	if cfg.Model != "claude-opus-4-20250514" {
+		t.Errorf("Model = %q, want it kept for the same provider", cfg.Model)
+	}
+}
+
+func TestRunSetup_ClaudeRequiresKey(t *testing.T) {
+	isolateConfig(t)
+
+	w := scriptedWizard("claude", []string{""}, nil)
+
+	err := runSetup(context.Background(), w)
+	if err == nil {
+		t.Fatal("expected error when no API key is provided")
+	}
+}
+
+func TestRunSetup_VerificationFailedNotSaved(t *testing.T) {
+	isolateConfig(t)
+
+	// Verify, then decline to save after the failure
+	w := scriptedWizard("claude", []string{"sk-ant-bad"}, []bool{true, false})
+	w.verifyClaude = func(ctx context.Context, apiKey string) error {
+		return errors.New("invalid x-api-key")
+	}
+
+	if err := runSetup(context.Background(), w); err != nil {
+		t.Fatalf("runSetup() failed: %v", err)
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		t.Fatalf("Load() failed: %v", err)
+	}
+	if cfg.AnthropicAPIKey != "" {
+		t.Errorf("config should not be saved, got AnthropicAPIKey = %q", cfg.AnthropicAPIKey)
+	}
+}
@@ -0,0 +1,101 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/mwistrand/graft/internal/git"
+	"github.com/mwistrand/graft/internal/provider"
+)
+
+// ReviewSpec describes a review from a JSON file, as written by CI pipelines.
+//
+// Example:
+//
+//	{
+//	  "base": "origin/main",
+//	  "head": "feature/login",
+//	  "title": "Add login page",
+//	  "description": "Implements the login form and session handling."
+//	}
+type ReviewSpec struct {
+	// Base is the ref to review against (required).
+	Base string `json:"base"`
+
+	// Head is the ref being reviewed (optional). Diffs are always taken
+	// against HEAD, so the review fails unless head is checked out.
+	Head string `json:"head,omitempty"`
+
+	// Title is the pull request title, added to the summary prompt (optional).
+	Title string `json:"title,omitempty"`
+
+	// Description is the pull request description, added to the summary prompt (optional).
+	Description string `json:"description,omitempty"`
+}
+
+// loadReviewSpec reads and validates a review spec file.
+func loadReviewSpec(path string) (*ReviewSpec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading spec file: %w", err)
+	}
+
+	var spec ReviewSpec
+	if err := json.Unmarshal(data, &spec); err != nil {
+		return nil, fmt.Errorf("parsing spec file %s: %w", path, err)
+	}
+
+	spec.Base = strings.TrimSpace(spec.Base)
+	spec.Head = strings.TrimSpace(spec.Head)
+	if spec.Base == "" {
+		return nil, fmt.Errorf("spec file %s: \"base\" is required", path)
+	}
+
+	return &spec, nil
+}
+
+// checkHead verifies that the spec's head ref is the checked out commit.
+// Diffs and the review cache are keyed on HEAD, so reviewing with a different
+// commit checked out would cover the wrong changes.
+func (s *ReviewSpec) checkHead(ctx context.Context, repo *git.Repository) error {
+	if s.Head == "" {
+		return nil
+	}
+
+	head, err := repo.GetCommit(ctx, s.Head)
+	if err != nil {
+		return fmt.Errorf("resolving spec head %q: %w", s.Head, err)
+	}
+	current, err := repo.GetCommit(ctx, "HEAD")
+	if err != nil {
+		return fmt.Errorf("resolving HEAD: %w", err)
+	}
+
+	if head.Hash != current.Hash {
+		return fmt.Errorf("spec head %q (%s) is not checked out (HEAD is %s); check it out before reviewing",
+			s.Head, head.ShortHash, current.ShortHash)
+	}
+	return nil
+}
+
+// applyTo adds the spec's pull request metadata to a summary request.
+// A nil spec leaves the request unchanged.
+func (s *ReviewSpec) applyTo(req *provider.SummarizeRequest) {
+	if s == nil {
+		return
+	}
+	req.Title = s.Title
+	req.Description = s.Description
+}
+
+// pullRequestHash returns the hash of the spec's pull request metadata for
+// the review cache. A nil spec has no metadata.
+func (s *ReviewSpec) pullRequestHash() string {
+	if s == nil {
+		return ""
+	}
+	return provider.HashPullRequest(s.Title, s.Description)
+}
@@ -0,0 +1,196 @@
+package cli
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mwistrand/graft/internal/git"
+	"github.com/mwistrand/graft/internal/provider"
+)
+
+// writeSpec writes content to a spec file in a temp directory and returns its path.
+func writeSpec(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "review.json")
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+// setupGitRepo creates a temporary git repository with an initial commit.
+func setupGitRepo(t *testing.T) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	runGit(t, dir, "init", "-b", "main")
+	runGit(t, dir, "config", "user.email", "test@example.com")
+	runGit(t, dir, "config", "user.name", "Test User")
+	runGit(t, dir, "commit", "--allow-empty", "-m", "Initial commit")
+	return dir
+}
+
+// runGit runs a git command in dir and returns its trimmed output.
+func runGit(t *testing.T, dir string, args ...string) string {
+	t.Helper()
+
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("git %v failed: %s\n%s", args, err, output)
+	}
+	return strings.TrimSpace(string(output))
+}
+
+func TestLoadReviewSpec(t *testing.T) {
+	path := writeSpec(t, `{
+		"base": "origin/main",
+		"head": "feature/login",
+		"title": "Add login page",
+		"description": "Implements the login form.\n\nCloses #42."
+	}`)
+
+	spec, err := loadReviewSpec(path)
+	if err != nil {
+		t.Fatalf("loadReviewSpec() failed: %v", err)
+	}
+
+	if spec.Base != "origin/main" {
+		t.Errorf("Base = %q, want %q", spec.Base, "origin/main")
+	}
+	if spec.Head != "feature/login" {
+		t.Errorf("Head = %q, want %q", spec.Head, "feature/login")
+	}
+	if spec.Title != "Add login page" {
+		t.Errorf("Title = %q, want %q", spec.Title, "Add login page")
+	}
+	if !strings.Contains(spec.Description, "Closes #42.") {
+		t.Errorf("unexpected Description: %q", spec.Description)
+	}
+}
+
+func TestLoadReviewSpec_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{"missing base", `{"title": "No base"}`},
+		{"blank base", `{"base": "  "}`},
+		{"malformed JSON", `{"base": "main"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := loadReviewSpec(writeSpec(t, tt.content)); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+
+	if _, err := loadReviewSpec(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Error("expected error for a missing file")
+	}
+}
+
+func TestReviewSpec_MetadataReachesPrompt(t *testing.T) {
+	spec, err := loadReviewSpec(writeSpec(t, `{
+		"base": "main",
+		"title": "Add login page",
+		"description": "Implements the login form and session handling."
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req := &provider.SummarizeRequest{
+		Files: []git.FileDiff{{Path: "login.go", Status: git.StatusAdded}},
+	}
+	spec.applyTo(req)
+
+	prompt := provider.BuildSummaryPrompt(req, provider.Capabilities{}, 0)
+	if !strings.Contains(prompt, "Add login page") {
+		t.Error("prompt should contain the spec title")
+	}
+	if !strings.Contains(prompt, "Implements the login form and session handling.") {
+		t.Error("prompt should contain the spec description")
+	}
+}
+
+func TestReviewSpec_ApplyToNil(t *testing.T) {
+	var spec *ReviewSpec
+	req := &provider.SummarizeRequest{}
+	spec.applyTo(req)
+
+	if req.Title != "" || req.Description != "" {
+		t.Errorf("nil spec should leave the request unchanged: %+v", req)
+	}
+}
+
+func TestReviewSpec_PullRequestHash(t *testing.T) {
+	var spec *ReviewSpec
+	if got := spec.pullRequestHash(); got != "" {
+		t.Errorf("nil spec hash = %q, want empty", got)
+	}
+
+	// A cached summary from a plain review has no hash, so any metadata invalidates it
+	spec = &ReviewSpec{Base: "main", Title: "Add login page"}
+	if spec.pullRequestHash() == "" {
+		t.Error("spec with a title should have a hash")
+	}
+
+	other := &ReviewSpec{Base: "main", Title: "Add login page", Description: "Session handling"}
+	if spec.pullRequestHash() == other.pullRequestHash() {
+		t.Error("different descriptions should produce different hashes")
+	}
+}
+
+func TestReviewSpec_CheckHead(t *testing.T) {
+	ctx := context.Background()
+	dir := setupGitRepo(t)
+	runGit(t, dir, "checkout", "-b", "feature")
+	runGit(t, dir, "commit", "--allow-empty", "-m", "Feature work")
+
+	repo, err := git.NewRepository(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, head := range []string{"", "feature", "HEAD"} {
+		spec := &ReviewSpec{Base: "main", Head: head}
+		if err := spec.checkHead(ctx, repo); err != nil {
+			t.Errorf("checkHead(%q) failed: %v", head, err)
+		}
+	}
+
+	spec := &ReviewSpec{Base: "main", Head: "main"}
+	if err := spec.checkHead(ctx, repo); err == nil || !strings.Contains(err.Error(), "not checked out") {
+		t.Errorf("checkHead() = %v, want an error that the head is not checked out", err)
+	}
+
+	spec = &ReviewSpec{Base: "main", Head: "missing"}
+	if err := spec.checkHead(ctx, repo); err == nil {
+		t.Error("expected error for a head that does not resolve")
+	}
+}
+
+func TestValidateReviewArgs_Spec(t *testing.T) {
+	defer func() { patchFile, specFile = "", "" }()
+
+	specFile = "review.json"
+	if err := validateReviewArgs(reviewCmd, nil); err != nil {
+		t.Errorf("unexpected error for --spec: %v", err)
+	}
+	if err := validateReviewArgs(reviewCmd, []string{"main"}); err == nil {
+		t.Error("expected error when combining a base branch with --spec")
+	}
+
+	patchFile = "changes.diff"
+	if err := validateReviewArgs(reviewCmd, nil); err == nil {
+		t.Error("expected error when combining --patch with --spec")
+	}
+}
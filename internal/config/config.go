@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
 )
 
 // Config holds all configuration for the graft CLI.
@@ -27,6 +29,26 @@ type Config struct {
 
 	// DeltaPath is the path to the delta binary. If empty, uses PATH lookup.
 	DeltaPath string `json:"delta_path,omitempty"`
+
+	// ConcernKeywords are highlighted in added diff lines during review.
+	// Nil uses DefaultConcernKeywords, so it is only saved once the user sets it;
+	// an empty list disables highlighting. Use Keywords to read it.
+	ConcernKeywords *[]string `json:"concern_keywords,omitempty"`
+
+	// SummaryMaxTokens, OrderMaxTokens and ReviewMaxTokens override the
+	// model's default response budget for each request type. Zero keeps the default.
+	SummaryMaxTokens int `json:"summary_max_tokens,omitempty"`
+	OrderMaxTokens   int `json:"order_max_tokens,omitempty"`
+	ReviewMaxTokens  int `json:"review_max_tokens,omitempty"`
+}
+
+// Keywords returns the concern keywords to highlight, falling back to
+// DefaultConcernKeywords if none were configured.
+func (c *Config) Keywords() []string {
+	if c.ConcernKeywords == nil {
+		return append([]string(nil), DefaultConcernKeywords...)
+	}
+	return *c.ConcernKeywords
 }
 
 // Load reads configuration from the default config file and environment variables.
@@ -89,21 +111,35 @@ func ConfigPath() (string, error) {
 }
 
 // Validate checks if the configuration has all required values for the selected provider.
-func (c *Config) Validate() error {
-	switch c.Provider {
-	case "claude", "":
+// providers lists the supported provider names; an empty provider selects DefaultProvider.
+func (c *Config) Validate(providers []string) error {
+	name := c.Provider
+	if name == "" {
+		name = DefaultProvider
+	}
+
+	known := false
+	for _, p := range providers {
+		if p == name {
+			known = true
+			break
+		}
+	}
+	if !known {
+		return fmt.Errorf("unknown provider %q; available providers: %s", name, strings.Join(providers, ", "))
+	}
+
+	switch name {
+	case "claude":
 		if c.AnthropicAPIKey == "" {
 			return errors.New("anthropic API key not set; run 'graft config set anthropic-api-key <key>' or set ANTHROPIC_API_KEY")
 		}
 	case "copilot":
 		// Copilot requires the copilot-api proxy to be running, no API key needed
-		return nil
 	case "openai":
 		if c.OpenAIAPIKey == "" {
 			return errors.New("openai API key not set; run 'graft config set openai-api-key <key>' or set OPENAI_API_KEY")
 		}
-	default:
-		return fmt.Errorf("unknown provider %q; available providers: claude, copilot", c.Provider)
 	}
 	return nil
 }
@@ -130,6 +166,20 @@ func (c *Config) applyEnvOverrides() {
 	}
 }
 
+// SecretKeys lists the configuration keys that hold credentials.
+// Their values are masked by Get and may be read from stdin by 'graft config set'.
+var SecretKeys = []string{"anthropic-api-key", "openai-api-key"}
+
+// IsSecretKey reports whether key holds a credential.
+func IsSecretKey(key string) bool {
+	for _, k := range SecretKeys {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
+
 // Set updates a configuration key with the given value.
 func (c *Config) Set(key, value string) error {
 	switch key {
@@ -145,6 +195,15 @@ func (c *Config) Set(key, value string) error {
 		c.CopilotBaseURL = value
 	case "delta-path":
 		c.DeltaPath = value
+	case "concern-keywords":
+		keywords := splitList(value)
+		c.ConcernKeywords = &keywords
+	case "summary-max-tokens":
+		return setTokenLimit(&c.SummaryMaxTokens, key, value)
+	case "order-max-tokens":
+		return setTokenLimit(&c.OrderMaxTokens, key, value)
+	case "review-max-tokens":
+		return setTokenLimit(&c.ReviewMaxTokens, key, value)
 	default:
 		return fmt.Errorf("unknown configuration key: %s", key)
 	}
@@ -172,11 +231,52 @@ func (c *Config) Get(key string) (string, error) {
 		return c.CopilotBaseURL, nil
 	case "delta-path":
 		return c.DeltaPath, nil
+	case "concern-keywords":
+		return strings.Join(c.Keywords(), ","), nil
+	case "summary-max-tokens":
+		return formatTokenLimit(c.SummaryMaxTokens), nil
+	case "order-max-tokens":
+		return formatTokenLimit(c.OrderMaxTokens), nil
+	case "review-max-tokens":
+		return formatTokenLimit(c.ReviewMaxTokens), nil
 	default:
 		return "", fmt.Errorf("unknown configuration key: %s", key)
 	}
 }
 
+// setTokenLimit parses a token budget; an empty value or 0 restores the model default.
+func setTokenLimit(field *int, key, value string) error {
+	if value == "" {
+		*field = 0
+		return nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 0 {
+		return fmt.Errorf("invalid %s %q: must be a non-negative integer", key, value)
+	}
+	*field = n
+	return nil
+}
+
+// formatTokenLimit returns a token budget for display, empty if unset.
+func formatTokenLimit(n int) string {
+	if n == 0 {
+		return ""
+	}
+	return strconv.Itoa(n)
+}
+
+// splitList parses a comma-separated list, dropping empty entries.
+func splitList(value string) []string {
+	items := []string{}
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
 // maskAPIKey returns a masked version of an API key for display.
 func maskAPIKey(key string) string {
 	if len(key) <= 8 {
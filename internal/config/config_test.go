@@ -3,6 +3,7 @@ package config
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -30,6 +31,10 @@ func TestConfigSetGet(t *testing.T) {
 		{"openai-api-key", "sk-test456"},
 		{"copilot-base-url", "http://localhost:5000"},
 		{"delta-path", "/usr/local/bin/delta"},
+		{"concern-keywords", "TODO,FIXME,unsafe"},
+		{"summary-max-tokens", "1024"},
+		{"order-max-tokens", "512"},
+		{"review-max-tokens", "8192"},
 	}
 
 	for _, tt := range tests {
@@ -57,6 +62,90 @@ func TestConfigSetGet(t *testing.T) {
 	}
 }
 
+func TestConfigSetConcernKeywords(t *testing.T) {
+	cfg := DefaultConfig()
+
+	if cfg.ConcernKeywords != nil {
+		t.Errorf("ConcernKeywords should be unset by default, got %v", *cfg.ConcernKeywords)
+	}
+	if got := cfg.Keywords(); len(got) != len(DefaultConcernKeywords) {
+		t.Fatalf("expected default keywords %v, got %v", DefaultConcernKeywords, got)
+	}
+
+	if err := cfg.Set("concern-keywords", " TODO , ,unsafe "); err != nil {
+		t.Fatalf("Set() failed: %v", err)
+	}
+	if got := cfg.Keywords(); len(got) != 2 || got[0] != "TODO" || got[1] != "unsafe" {
+		t.Errorf("Keywords() = %v, want [TODO unsafe]", got)
+	}
+
+	// An empty value disables highlighting
+	if err := cfg.Set("concern-keywords", ""); err != nil {
+		t.Fatalf("Set() failed: %v", err)
+	}
+	if got := cfg.Keywords(); len(got) != 0 {
+		t.Errorf("expected no keywords, got %v", got)
+	}
+}
+
+func TestConfigSaveConcernKeywords(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	// Defaults are not written, so later changes to them reach the user
+	cfg := DefaultConfig()
+	if err := cfg.Save(); err != nil {
+		t.Fatalf("Save() failed: %v", err)
+	}
+	path, _ := ConfigPath()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "concern_keywords") {
+		t.Errorf("default keywords should not be saved:\n%s", data)
+	}
+
+	// A disabled list survives a round trip
+	if err := cfg.Set("concern-keywords", ""); err != nil {
+		t.Fatal(err)
+	}
+	if err := cfg.Save(); err != nil {
+		t.Fatalf("Save() failed: %v", err)
+	}
+	loaded, err := Load()
+	if err != nil {
+		t.Fatalf("Load() failed: %v", err)
+	}
+	if loaded.ConcernKeywords == nil || len(loaded.Keywords()) != 0 {
+		t.Errorf("disabled keywords should load as an empty list, got %v", loaded.Keywords())
+	}
+}
+
+func TestConfigSetTokenLimits(t *testing.T) {
+	cfg := DefaultConfig()
+
+	for _, value := range []string{"abc", "-1", "1.5"} {
+		if err := cfg.Set("review-max-tokens", value); err == nil {
+			t.Errorf("Set(review-max-tokens, %q) should fail", value)
+		}
+	}
+
+	if err := cfg.Set("review-max-tokens", "4096"); err != nil {
+		t.Fatalf("Set() failed: %v", err)
+	}
+	if cfg.ReviewMaxTokens != 4096 {
+		t.Errorf("ReviewMaxTokens = %d, want 4096", cfg.ReviewMaxTokens)
+	}
+
+	// An empty value restores the model default
+	if err := cfg.Set("review-max-tokens", ""); err != nil {
+		t.Fatalf("Set() failed: %v", err)
+	}
+	if got, _ := cfg.Get("review-max-tokens"); got != "" || cfg.ReviewMaxTokens != 0 {
+		t.Errorf("after clearing got %q (%d), want unset", got, cfg.ReviewMaxTokens)
+	}
+}
+
 func TestConfigSetUnknownKey(t *testing.T) {
 	cfg := DefaultConfig()
 	err := cfg.Set("unknown-key", "value")
@@ -74,6 +163,8 @@ func TestConfigGetUnknownKey(t *testing.T) {
 }
 
 func TestConfigValidate(t *testing.T) {
+	providers := []string{"claude", "copilot", "openai"}
+
 	tests := []struct {
 		name    string
 		cfg     *Config
@@ -134,7 +225,7 @@ func TestConfigValidate(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := tt.cfg.Validate()
+			err := tt.cfg.Validate(providers)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
 			}
@@ -142,6 +233,18 @@ func TestConfigValidate(t *testing.T) {
 	}
 }
 
+func TestConfigValidate_ListsProviders(t *testing.T) {
+	cfg := &Config{Provider: "openai", OpenAIAPIKey: "sk-test"}
+
+	err := cfg.Validate([]string{"claude", "copilot"})
+	if err == nil {
+		t.Fatal("expected error for a provider that is not listed")
+	}
+	if !strings.Contains(err.Error(), "available providers: claude, copilot") {
+		t.Errorf("error should list the given providers: %v", err)
+	}
+}
+
 func TestConfigEnvOverrides(t *testing.T) {
 	// Save and restore environment
 	envVars := []string{"GRAFT_PROVIDER", "GRAFT_MODEL", "ANTHROPIC_API_KEY", "OPENAI_API_KEY", "COPILOT_BASE_URL", "GRAFT_DELTA_PATH"}
@@ -263,3 +366,16 @@ func TestMaskAPIKey(t *testing.T) {
 		})
 	}
 }
+
+func TestIsSecretKey(t *testing.T) {
+	for _, key := range []string{"anthropic-api-key", "openai-api-key"} {
+		if !IsSecretKey(key) {
+			t.Errorf("IsSecretKey(%q) = false, want true", key)
+		}
+	}
+	for _, key := range []string{"provider", "model", "copilot-base-url", ""} {
+		if IsSecretKey(key) {
+			t.Errorf("IsSecretKey(%q) = true, want false", key)
+		}
+	}
+}
@@ -21,6 +21,9 @@ const (
 	DefaultConfigFile = "config.json"
 )
 
+// DefaultConcernKeywords are the risky patterns highlighted in added diff lines.
+var DefaultConcernKeywords = []string{"TODO", "FIXME", "panic", "eval", "exec", "password"}
+
 // DefaultConfig returns a Config with default values.
 func DefaultConfig() *Config {
 	return &Config{
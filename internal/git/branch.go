@@ -24,6 +24,15 @@ type BranchInfo struct {
 	BehindBy int
 }
 
+// StaleThreshold is the number of commits a local branch can be behind its
+// upstream before it is considered stale.
+const StaleThreshold = 10
+
+// IsStale reports whether the branch is at least StaleThreshold commits behind its upstream.
+func (b *BranchInfo) IsStale() bool {
+	return b.Upstream != "" && b.BehindBy >= StaleThreshold
+}
+
 // GetBranchInfo returns information about the specified branch.
 func (r *Repository) GetBranchInfo(ctx context.Context, branch string) (*BranchInfo, error) {
 	info := &BranchInfo{
@@ -55,6 +64,13 @@ func (r *Repository) GetBranchInfo(ctx context.Context, branch string) (*BranchI
 	return info, nil
 }
 
+// IsLocalBranch reports whether name is a local branch, as opposed to a
+// remote-tracking branch, tag, commit, or expression such as HEAD~3.
+func (r *Repository) IsLocalBranch(ctx context.Context, name string) bool {
+	_, err := r.run(ctx, "rev-parse", "--verify", "--quiet", "refs/heads/"+name)
+	return err == nil
+}
+
 // ListBranches returns all local branches.
 func (r *Repository) ListBranches(ctx context.Context) ([]string, error) {
 	return r.listBranches(ctx)
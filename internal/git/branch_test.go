@@ -0,0 +1,104 @@
+package git
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// setupClone clones a test repository and then adds behind commits to the
+// original, so the clone's local branch is behind its origin upstream after a fetch.
+func setupClone(t *testing.T, behind int) (string, string) {
+	t.Helper()
+
+	origin := setupTestRepo(t)
+	branch := runGit(t, origin, "rev-parse", "--abbrev-ref", "HEAD")
+
+	clone := t.TempDir()
+	runGit(t, clone, "clone", origin, ".")
+	runGit(t, clone, "config", "user.email", "test@example.com")
+	runGit(t, clone, "config", "user.name", "Test User")
+
+	for i := 0; i < behind; i++ {
+		runGit(t, origin, "commit", "--allow-empty", "-m", fmt.Sprintf("Upstream change %d", i))
+	}
+	runGit(t, clone, "fetch", "origin")
+
+	return clone, strings.TrimSpace(branch)
+}
+
+func TestGetBranchInfo_BehindUpstream(t *testing.T) {
+	dir, branch := setupClone(t, StaleThreshold+2)
+	repo, _ := NewRepository(dir)
+
+	info, err := repo.GetBranchInfo(context.Background(), branch)
+	if err != nil {
+		t.Fatalf("GetBranchInfo() error = %v", err)
+	}
+
+	if info.Upstream != "origin/"+branch {
+		t.Errorf("Upstream = %q, want %q", info.Upstream, "origin/"+branch)
+	}
+	if info.BehindBy != StaleThreshold+2 {
+		t.Errorf("BehindBy = %d, want %d", info.BehindBy, StaleThreshold+2)
+	}
+	if info.AheadBy != 0 {
+		t.Errorf("AheadBy = %d, want 0", info.AheadBy)
+	}
+	if !info.IsStale() {
+		t.Error("IsStale() = false for a branch far behind its upstream")
+	}
+}
+
+func TestGetBranchInfo_SlightlyBehind(t *testing.T) {
+	dir, branch := setupClone(t, 2)
+	repo, _ := NewRepository(dir)
+
+	info, err := repo.GetBranchInfo(context.Background(), branch)
+	if err != nil {
+		t.Fatalf("GetBranchInfo() error = %v", err)
+	}
+
+	if info.BehindBy != 2 {
+		t.Errorf("BehindBy = %d, want 2", info.BehindBy)
+	}
+	if info.IsStale() {
+		t.Error("IsStale() = true for a branch only 2 commits behind")
+	}
+}
+
+func TestGetBranchInfo_NoUpstream(t *testing.T) {
+	dir := setupTestRepo(t)
+	repo, _ := NewRepository(dir)
+	ctx := context.Background()
+
+	branch, _ := repo.GetCurrentBranch(ctx)
+	info, err := repo.GetBranchInfo(ctx, branch)
+	if err != nil {
+		t.Fatalf("GetBranchInfo() error = %v", err)
+	}
+
+	if info.Upstream != "" {
+		t.Errorf("Upstream = %q, want empty", info.Upstream)
+	}
+	if info.IsStale() {
+		t.Error("IsStale() = true for a branch without an upstream")
+	}
+}
+
+func TestIsLocalBranch(t *testing.T) {
+	dir, branch := setupClone(t, 1)
+	repo, _ := NewRepository(dir)
+	ctx := context.Background()
+	runGit(t, dir, "tag", "v1.0")
+
+	if !repo.IsLocalBranch(ctx, branch) {
+		t.Errorf("IsLocalBranch(%q) = false, want true", branch)
+	}
+	for _, ref := range []string{"HEAD", "origin/" + branch, "v1.0", "missing"} {
+		if repo.IsLocalBranch(ctx, ref) {
+			t.Errorf("IsLocalBranch(%q) = true, want false", ref)
+		}
+	}
+}
@@ -0,0 +1,177 @@
+package git
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ErrEmptyPatch is returned when patch text contains no file diffs.
+var ErrEmptyPatch = errors.New("no file changes found in patch")
+
+// hunkHeaderRegex captures the optional old and new line counts of a hunk header.
+var hunkHeaderRegex = regexp.MustCompile(`^@@ -\d+(,\d+)? \+\d+(,\d+)? @@`)
+
+// ParsePatch parses unified diff text into per-file diffs.
+// It accepts `git diff` and `git format-patch` output as well as plain `diff -u`
+// output. Any text before the first file header (such as email headers) is ignored.
+// Returns ErrEmptyPatch if no file diffs are found.
+func ParsePatch(patch string) ([]FileDiff, DiffStats, error) {
+	var files []FileDiff
+	var stats DiffStats
+
+	var current *FileDiff
+	var section []string
+
+	// gitHeader is true between a "diff --git" line and its first hunk
+	gitHeader := false
+
+	// Remaining old/new line counts in the current hunk
+	oldLeft, newLeft := 0, 0
+
+	flush := func() {
+		if current == nil {
+			return
+		}
+		current.Patch = strings.Join(section, "\n") + "\n"
+		if current.Path == "" {
+			// Deleted files only have an old path
+			current.Path = current.OldPath
+		}
+		if current.Status != StatusRenamed {
+			current.OldPath = ""
+		}
+		files = append(files, *current)
+		stats.FilesChanged++
+		stats.Additions += current.Additions
+		stats.Deletions += current.Deletions
+		current = nil
+		section = nil
+	}
+
+	// Drop the final newline so the last file's section has no trailing empty line
+	patch = strings.TrimSuffix(strings.ReplaceAll(patch, "\r\n", "\n"), "\n")
+	lines := strings.Split(patch, "\n")
+	for i, line := range lines {
+		inHunk := oldLeft > 0 || newLeft > 0
+
+		switch {
+		case inHunk:
+			switch {
+			case strings.HasPrefix(line, "+"):
+				current.Additions++
+				newLeft--
+			case strings.HasPrefix(line, "-"):
+				current.Deletions++
+				oldLeft--
+			case strings.HasPrefix(line, "\\"):
+				// "\ No newline at end of file"
+			default:
+				oldLeft--
+				newLeft--
+			}
+
+		case strings.HasPrefix(line, "diff --git "):
+			flush()
+			current = &FileDiff{Status: StatusModified}
+			current.OldPath, current.Path = parseGitHeaderPaths(strings.TrimPrefix(line, "diff --git "))
+			gitHeader = true
+
+		case strings.HasPrefix(line, "--- ") && i+1 < len(lines) && strings.HasPrefix(lines[i+1], "+++ "):
+			// Plain unified diffs have no "diff --git" line, so "---" starts a new file
+			if !gitHeader {
+				flush()
+				current = &FileDiff{Status: StatusModified}
+			}
+			if oldPath := parsePatchPath(strings.TrimPrefix(line, "--- ")); oldPath == "" {
+				current.Status = StatusAdded
+			} else if current.Status != StatusRenamed {
+				current.OldPath = oldPath
+			}
+
+		case strings.HasPrefix(line, "+++ ") && current != nil:
+			if newPath := parsePatchPath(strings.TrimPrefix(line, "+++ ")); newPath == "" {
+				current.Status = StatusDeleted
+				current.Path = ""
+			} else {
+				current.Path = newPath
+			}
+
+		case current == nil:
+			// Preamble before the first file (commit message, email headers)
+			continue
+
+		case strings.HasPrefix(line, "@@"):
+			gitHeader = false
+			oldLeft, newLeft = parseHunkCounts(line)
+
+		case strings.HasPrefix(line, "new file mode"):
+			current.Status = StatusAdded
+		case strings.HasPrefix(line, "deleted file mode"):
+			current.Status = StatusDeleted
+		case strings.HasPrefix(line, "rename from "):
+			current.Status = StatusRenamed
+			current.OldPath = strings.TrimPrefix(line, "rename from ")
+		case strings.HasPrefix(line, "rename to "):
+			current.Status = StatusRenamed
+			current.Path = strings.TrimPrefix(line, "rename to ")
+		case strings.HasPrefix(line, "Binary files ") || line == "GIT binary patch":
+			current.IsBinary = true
+		}
+
+		section = append(section, line)
+	}
+	flush()
+
+	if len(files) == 0 {
+		return nil, DiffStats{}, ErrEmptyPatch
+	}
+
+	return files, stats, nil
+}
+
+// parseHunkCounts returns the old and new line counts from a hunk header
+// such as "@@ -1,3 +1,4 @@". Omitted counts default to 1.
+func parseHunkCounts(header string) (int, int) {
+	matches := hunkHeaderRegex.FindStringSubmatch(header)
+	if len(matches) != 3 {
+		return 0, 0
+	}
+	return hunkCount(matches[1]), hunkCount(matches[2])
+}
+
+// hunkCount parses an optional ",count" suffix from a hunk range.
+func hunkCount(s string) int {
+	if s == "" {
+		return 1
+	}
+	n, _ := strconv.Atoi(s[1:])
+	return n
+}
+
+// parseGitHeaderPaths extracts the old and new paths from "a/old b/new".
+func parseGitHeaderPaths(s string) (string, string) {
+	idx := strings.LastIndex(s, " b/")
+	if idx == -1 || !strings.HasPrefix(s, "a/") {
+		return "", ""
+	}
+	return s[2:idx], s[idx+3:]
+}
+
+// parsePatchPath extracts a file path from a "---" or "+++" header value.
+// Returns an empty string for /dev/null.
+func parsePatchPath(s string) string {
+	// diff -u appends a tab and timestamp after the path
+	if idx := strings.Index(s, "\t"); idx != -1 {
+		s = s[:idx]
+	}
+	s = strings.TrimSpace(s)
+	if s == "/dev/null" {
+		return ""
+	}
+	if strings.HasPrefix(s, "a/") || strings.HasPrefix(s, "b/") {
+		return s[2:]
+	}
+	return s
+}
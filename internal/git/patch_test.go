@@ -0,0 +1,137 @@
+package git
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestParsePatch_SampleFile(t *testing.T) {
+	data, err := os.ReadFile("testdata/sample.diff")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	files, stats, err := ParsePatch(string(data))
+	if err != nil {
+		t.Fatalf("ParsePatch() failed: %v", err)
+	}
+
+	if len(files) != 4 {
+		t.Fatalf("expected 4 files, got %d", len(files))
+	}
+
+	tests := []struct {
+		path      string
+		oldPath   string
+		status    string
+		additions int
+		deletions int
+	}{
+		{"cmd/app/main.go", "", StatusModified, 2, 1},
+		{"internal/greet/greet.go", "", StatusAdded, 5, 0},
+		{"new_name.go", "old_name.go", StatusRenamed, 0, 0},
+		{"legacy.go", "", StatusDeleted, 0, 3},
+	}
+
+	for i, tt := range tests {
+		f := files[i]
+		if f.Path != tt.path {
+			t.Errorf("files[%d].Path = %q, want %q", i, f.Path, tt.path)
+		}
+		if f.OldPath != tt.oldPath {
+			t.Errorf("files[%d].OldPath = %q, want %q", i, f.OldPath, tt.oldPath)
+		}
+		if f.Status != tt.status {
+			t.Errorf("files[%d].Status = %q, want %q", i, f.Status, tt.status)
+		}
+		if f.Additions != tt.additions || f.Deletions != tt.deletions {
+			t.Errorf("files[%d] = +%d/-%d, want +%d/-%d", i, f.Additions, f.Deletions, tt.additions, tt.deletions)
+		}
+	}
+
+	if stats.FilesChanged != 4 || stats.Additions != 7 || stats.Deletions != 4 {
+		t.Errorf("stats = %+v, want 4 files, +7/-4", stats)
+	}
+
+	// Each file keeps its own section of the patch
+	if !strings.HasPrefix(files[0].Patch, "diff --git a/cmd/app/main.go") {
+		t.Errorf("unexpected patch start: %q", files[0].Patch)
+	}
+	if !strings.Contains(files[0].Patch, "+\tgreet.Hello()") {
+		t.Error("patch should contain the added line")
+	}
+	if strings.Contains(files[0].Patch, "greet.go") {
+		t.Error("patch should not contain other files")
+	}
+	if strings.HasSuffix(files[3].Patch, "\n\n") {
+		t.Error("last patch should not gain a trailing empty line")
+	}
+}
+
+func TestParsePatch_PlainUnifiedDiff(t *testing.T) {
+	patch := `--- a.txt	2025-01-01 10:00:00.000000000 +0000
++++ a.txt	2025-01-02 10:00:00.000000000 +0000
+@@ -1,2 +1,2 @@
+ first
+-second
++second line
+--- b.txt	2025-01-01 10:00:00.000000000 +0000
++++ b.txt	2025-01-02 10:00:00.000000000 +0000
+@@ -1 +1,2 @@
+ only
++added
+`
+
+	files, stats, err := ParsePatch(patch)
+	if err != nil {
+		t.Fatalf("ParsePatch() failed: %v", err)
+	}
+
+	if len(files) != 2 {
+		t.Fatalf("expected 2 files, got %d", len(files))
+	}
+	if files[0].Path != "a.txt" || files[0].Additions != 1 || files[0].Deletions != 1 {
+		t.Errorf("unexpected first file: %+v", files[0])
+	}
+	if files[1].Path != "b.txt" || files[1].Additions != 1 || files[1].Deletions != 0 {
+		t.Errorf("unexpected second file: %+v", files[1])
+	}
+	if stats.Additions != 2 || stats.Deletions != 1 {
+		t.Errorf("stats = %+v, want +2/-1", stats)
+	}
+}
+
+func TestParsePatch_NotADiff(t *testing.T) {
+	tests := []string{
+		"",
+		"just some text\nwith no diff in it\n",
+	}
+
+	for _, input := range tests {
+		_, _, err := ParsePatch(input)
+		if err != ErrEmptyPatch {
+			t.Errorf("ParsePatch(%q) error = %v, want ErrEmptyPatch", input, err)
+		}
+	}
+}
+
+func TestParsePatchPath(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"a/src/main.go", "src/main.go"},
+		{"b/src/main.go", "src/main.go"},
+		{"/dev/null", ""},
+		{"notes.txt\t2025-01-01 10:00:00", "notes.txt"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := parsePatchPath(tt.input); got != tt.want {
+				t.Errorf("parsePatchPath(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ package prompt
 import (
 	"bufio"
 	"fmt"
+	"io"
 	"os"
 	"strings"
 
@@ -149,3 +150,119 @@ func SelectGroups(groups []provider.OrderGroup, fileCounts map[string]int) ([]pr
 
 	return result, nil
 }
+
+// SelectProvider displays an interactive list of providers and returns the selected name.
+// If providers is empty or stdin is not a terminal, returns an error.
+func SelectProvider(providers []string) (string, error) {
+	if len(providers) == 0 {
+		return "", fmt.Errorf("no providers available")
+	}
+
+	if !IsInteractive() {
+		return "", fmt.Errorf("cannot prompt for provider: not running in an interactive terminal")
+	}
+
+	options := make([]huh.Option[string], len(providers))
+	for i, p := range providers {
+		options[i] = huh.NewOption(p, p)
+	}
+
+	var selected string
+	form := huh.NewForm(
+		huh.NewGroup(
+			huh.NewSelect[string]().
+				Title("Select an AI provider").
+				Description("Use arrow keys to navigate, enter to select").
+				Options(options...).
+				Value(&selected),
+		),
+	).WithAccessible(false)
+
+	if err := form.Run(); err != nil {
+		return "", fmt.Errorf("provider selection: %w", err)
+	}
+
+	return selected, nil
+}
+
+// Input prompts the user for a single line of text.
+// If secret is true, the input is masked while typing.
+// If stdin is not a terminal, returns an error.
+func Input(title, description string, secret bool) (string, error) {
+	if !IsInteractive() {
+		return "", fmt.Errorf("cannot prompt for input: not running in an interactive terminal")
+	}
+
+	var value string
+	input := huh.NewInput().
+		Title(title).
+		Description(description).
+		Value(&value)
+	if secret {
+		input = input.EchoMode(huh.EchoModePassword)
+	}
+
+	form := huh.NewForm(huh.NewGroup(input)).WithAccessible(false)
+	if err := form.Run(); err != nil {
+		return "", fmt.Errorf("input: %w", err)
+	}
+
+	return strings.TrimSpace(value), nil
+}
+
+// ReadSecret reads a secret value from r.
+// If r is a terminal, title is printed to w and the value is read without echo.
+// Otherwise the piped input is read in full; surrounding whitespace is trimmed.
+// Returns an error if the value is empty or spans multiple lines.
+func ReadSecret(r io.Reader, w io.Writer, title string) (string, error) {
+	var data []byte
+	if f, ok := r.(*os.File); ok && term.IsTerminal(int(f.Fd())) {
+		fmt.Fprintf(w, "%s: ", title)
+		secret, err := term.ReadPassword(int(f.Fd()))
+		fmt.Fprintln(w)
+		if err != nil {
+			return "", fmt.Errorf("reading secret: %w", err)
+		}
+		data = secret
+	} else {
+		piped, err := io.ReadAll(r)
+		if err != nil {
+			return "", fmt.Errorf("reading secret from stdin: %w", err)
+		}
+		data = piped
+	}
+
+	value := strings.TrimSpace(string(data))
+	if value == "" {
+		return "", fmt.Errorf("no value provided on stdin")
+	}
+	if strings.ContainsAny(value, "\r\n") {
+		return "", fmt.Errorf("expected a single line on stdin, got multiple lines")
+	}
+	return value, nil
+}
+
+// Confirm asks the user a yes/no question.
+// If stdin is not a terminal, returns an error.
+func Confirm(title string) (bool, error) {
+	if !IsInteractive() {
+		return false, fmt.Errorf("cannot prompt for confirmation: not running in an interactive terminal")
+	}
+
+	var confirmed bool
+	form := huh.NewForm(
+		huh.NewGroup(
+			huh.NewConfirm().
+				Title(title).
+				Affirmative("Yes").
+				Negative("No").
+				Value(&confirmed),
+		),
+	).WithAccessible(false)
+
+	if err := form.Run(); err != nil {
+		return false, fmt.Errorf("confirmation: %w", err)
+	}
+
+	return confirmed, nil
+}
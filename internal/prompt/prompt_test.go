@@ -1,6 +1,9 @@
 package prompt
 
 import (
+	"bytes"
+	"io"
+	"strings"
 	"testing"
 
 	"github.com/mwistrand/graft/internal/provider"
@@ -132,3 +135,79 @@ func TestSelectGroups_NonInteractive_SingleGroup(t *testing.T) {
 		t.Errorf("expected group name 'Only Group', got %q", result[0].Name)
 	}
 }
+
+func TestSelectProvider_EmptyProviders(t *testing.T) {
+	_, err := SelectProvider(nil)
+	if err == nil {
+		t.Error("expected error for empty providers")
+	}
+	if err.Error() != "no providers available" {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestSelectProvider_NonInteractive(t *testing.T) {
+	if IsInteractive() {
+		t.Skip("skipping: stdin is a terminal in this test environment")
+	}
+
+	_, err := SelectProvider([]string{"claude", "copilot"})
+	if err == nil {
+		t.Error("expected error for non-interactive terminal")
+	}
+}
+
+func TestInput_NonInteractive(t *testing.T) {
+	if IsInteractive() {
+		t.Skip("skipping: stdin is a terminal in this test environment")
+	}
+
+	_, err := Input("API key", "", true)
+	if err == nil {
+		t.Error("expected error for non-interactive terminal")
+	}
+}
+
+func TestConfirm_NonInteractive(t *testing.T) {
+	if IsInteractive() {
+		t.Skip("skipping: stdin is a terminal in this test environment")
+	}
+
+	_, err := Confirm("Verify now?")
+	if err == nil {
+		t.Error("expected error for non-interactive terminal")
+	}
+}
+
+func TestReadSecret_Piped(t *testing.T) {
+	var prompted bytes.Buffer
+	value, err := ReadSecret(strings.NewReader("  sk-ant-secret-value\n"), &prompted, "Enter key")
+	if err != nil {
+		t.Fatalf("ReadSecret() failed: %v", err)
+	}
+	if value != "sk-ant-secret-value" {
+		t.Errorf("value = %q, want %q", value, "sk-ant-secret-value")
+	}
+	if prompted.Len() != 0 {
+		t.Errorf("piped input should not print a prompt, got %q", prompted.String())
+	}
+}
+
+func TestReadSecret_Invalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"empty", ""},
+		{"whitespace only", " \n\n"},
+		{"multiple lines", "first\nsecond\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ReadSecret(strings.NewReader(tt.input), io.Discard, "Enter key"); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
@@ -4,10 +4,12 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/mwistrand/graft/internal/git"
@@ -21,6 +23,12 @@ const (
 	ReviewCacheDir = "reviews"
 )
 
+// ErrCacheNotFound is returned when exporting a cache key that does not exist.
+var ErrCacheNotFound = errors.New("cached review not found")
+
+// ErrCommitMismatch is returned when an imported review was made for different commits.
+var ErrCommitMismatch = errors.New("cached review does not match the current commits")
+
 // CachedReview contains cached AI responses for a review.
 type CachedReview struct {
 	// CacheKey is the unique identifier for this review.
@@ -29,6 +37,13 @@ type CachedReview struct {
 	// BaseRef is the base reference used for the review.
 	BaseRef string `json:"base_ref"`
 
+	// Persona is the reviewer persona used for the summary and review, if any.
+	Persona string `json:"persona,omitempty"`
+
+	// PullRequestHash identifies the pull request title and description given
+	// to the summary, if any. See HashPullRequest.
+	PullRequestHash string `json:"pull_request_hash,omitempty"`
+
 	// CommitHashes are the commit hashes that were reviewed.
 	CommitHashes []string `json:"commit_hashes"`
 
@@ -78,6 +93,21 @@ func GenerateCacheKey(baseRef string, commits []git.Commit) string {
 	return hex.EncodeToString(h.Sum(nil))[:16]
 }
 
+// HashPullRequest returns a short hash of pull request metadata, so a cached
+// summary can be discarded when the metadata changes.
+// Returns an empty string if both title and description are empty.
+func HashPullRequest(title, description string) string {
+	if title == "" && description == "" {
+		return ""
+	}
+
+	h := sha256.New()
+	h.Write([]byte(title))
+	h.Write([]byte{0}) // separator
+	h.Write([]byte(description))
+	return hex.EncodeToString(h.Sum(nil))[:16]
+}
+
 // CacheDirectory returns the full path to the review cache directory.
 func (c *ReviewCache) CacheDirectory() string {
 	return filepath.Join(c.repoRoot, CacheDir, ReviewCacheDir)
@@ -215,3 +245,69 @@ func (c *ReviewCache) Count() (int, error) {
 	}
 	return len(reviews), nil
 }
+
+// Export writes the cached review for cacheKey to path so it can be shared.
+// Returns ErrCacheNotFound if no valid review is cached under cacheKey.
+func (c *ReviewCache) Export(cacheKey, path string) error {
+	cached, err := c.Load(cacheKey)
+	if err != nil {
+		return err
+	}
+	if cached == nil {
+		return fmt.Errorf("%w: %s", ErrCacheNotFound, cacheKey)
+	}
+
+	data, err := json.MarshalIndent(cached, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshaling review cache: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("writing exported review: %w", err)
+	}
+
+	return nil
+}
+
+// ReadExportedReview reads a review written by Export.
+// Unlike Load, invalid content is an error rather than a cache miss.
+func ReadExportedReview(path string) (*CachedReview, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading exported review: %w", err)
+	}
+
+	var cached CachedReview
+	if err := json.Unmarshal(data, &cached); err != nil {
+		return nil, fmt.Errorf("parsing exported review %s: %w", path, err)
+	}
+
+	if cached.CacheKey == "" || cached.BaseRef == "" {
+		return nil, fmt.Errorf("exported review %s is missing its cache key or base ref", path)
+	}
+
+	return &cached, nil
+}
+
+// ValidateCommits checks that the review was made for exactly the given commits.
+// Returns an error wrapping ErrCommitMismatch if the commits or cache key differ.
+func (r *CachedReview) ValidateCommits(commits []git.Commit) error {
+	want := make([]string, len(commits))
+	for i, c := range commits {
+		want[i] = c.Hash
+	}
+	got := append([]string(nil), r.CommitHashes...)
+	sort.Strings(want)
+	sort.Strings(got)
+
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		return fmt.Errorf("%w: review covers %d commit(s) against %s, branch has %d",
+			ErrCommitMismatch, len(got), r.BaseRef, len(want))
+	}
+
+	if key := GenerateCacheKey(r.BaseRef, commits); key != r.CacheKey {
+		return fmt.Errorf("%w: cache key %s does not match %s", ErrCommitMismatch, r.CacheKey, key)
+	}
+
+	return nil
+}
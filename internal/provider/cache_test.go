@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -64,6 +65,26 @@ func TestGenerateCacheKey_EmptyCommits(t *testing.T) {
 	}
 }
 
+func TestHashPullRequest(t *testing.T) {
+	if got := HashPullRequest("", ""); got != "" {
+		t.Errorf("HashPullRequest() without metadata = %q, want empty", got)
+	}
+
+	hash := HashPullRequest("Add login", "Implements the login form.")
+	if len(hash) != 16 {
+		t.Errorf("expected hash length 16, got %d", len(hash))
+	}
+	if hash != HashPullRequest("Add login", "Implements the login form.") {
+		t.Error("same metadata should produce the same hash")
+	}
+	if hash == HashPullRequest("Add login", "Implements the login page.") {
+		t.Error("a changed description should produce a different hash")
+	}
+	if HashPullRequest("ab", "c") == HashPullRequest("a", "bc") {
+		t.Error("title and description should be hashed separately")
+	}
+}
+
 func TestReviewCache_SaveAndLoad(t *testing.T) {
 	// Create temp directory
 	tmpDir := t.TempDir()
@@ -598,3 +619,111 @@ func TestReviewCache_PartialCache_NoReview(t *testing.T) {
 		t.Error("Review should be nil")
 	}
 }
+
+func TestReviewCache_ExportAndRead(t *testing.T) {
+	cache := NewReviewCache(t.TempDir())
+
+	commits := []git.Commit{{Hash: "abc123"}, {Hash: "def456"}}
+	original := &CachedReview{
+		CacheKey:     GenerateCacheKey("main", commits),
+		BaseRef:      "main",
+		CommitHashes: []string{"abc123", "def456"},
+		Summary:      &SummarizeResponse{Overview: "Shared overview"},
+		Review:       &ReviewResponse{Content: "# Review"},
+		CachedAt:     time.Now(),
+	}
+	if err := cache.Save(original); err != nil {
+		t.Fatal(err)
+	}
+
+	path := filepath.Join(t.TempDir(), "review.json")
+	if err := cache.Export(original.CacheKey, path); err != nil {
+		t.Fatalf("Export() failed: %v", err)
+	}
+
+	imported, err := ReadExportedReview(path)
+	if err != nil {
+		t.Fatalf("ReadExportedReview() failed: %v", err)
+	}
+	if imported.CacheKey != original.CacheKey || imported.BaseRef != "main" {
+		t.Errorf("unexpected imported review: %+v", imported)
+	}
+	if imported.Summary == nil || imported.Summary.Overview != "Shared overview" {
+		t.Error("summary should survive the round-trip")
+	}
+	if imported.Review == nil || imported.Review.Content != "# Review" {
+		t.Error("review should survive the round-trip")
+	}
+	if err := imported.ValidateCommits(commits); err != nil {
+		t.Errorf("ValidateCommits() failed: %v", err)
+	}
+}
+
+func TestReviewCache_ExportMissing(t *testing.T) {
+	cache := NewReviewCache(t.TempDir())
+
+	err := cache.Export("missing", filepath.Join(t.TempDir(), "review.json"))
+	if !errors.Is(err, ErrCacheNotFound) {
+		t.Errorf("Export() error = %v, want ErrCacheNotFound", err)
+	}
+}
+
+func TestReadExportedReview_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{"malformed JSON", `{"cache_key": `},
+		{"missing cache key", `{"base_ref": "main"}`},
+		{"missing base ref", `{"cache_key": "abc"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "review.json")
+			if err := os.WriteFile(path, []byte(tt.content), 0644); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := ReadExportedReview(path); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
+
+func TestCachedReview_ValidateCommits_Mismatch(t *testing.T) {
+	commits := []git.Commit{{Hash: "abc123"}, {Hash: "def456"}}
+	review := &CachedReview{
+		CacheKey:     GenerateCacheKey("main", commits),
+		BaseRef:      "main",
+		CommitHashes: []string{"def456", "abc123"},
+	}
+
+	// Commit order does not matter
+	if err := review.ValidateCommits([]git.Commit{{Hash: "def456"}, {Hash: "abc123"}}); err != nil {
+		t.Errorf("ValidateCommits() failed for reordered commits: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		commits []git.Commit
+	}{
+		{"extra commit", append(commits, git.Commit{Hash: "999fff"})},
+		{"missing commit", commits[:1]},
+		{"different commit", []git.Commit{{Hash: "abc123"}, {Hash: "000000"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := review.ValidateCommits(tt.commits); !errors.Is(err, ErrCommitMismatch) {
+				t.Errorf("ValidateCommits() error = %v, want ErrCommitMismatch", err)
+			}
+		})
+	}
+
+	// A tampered cache key is rejected even if the hashes match
+	tampered := *review
+	tampered.CacheKey = "0000000000000000"
+	if err := tampered.ValidateCommits(commits); !errors.Is(err, ErrCommitMismatch) {
+		t.Errorf("ValidateCommits() error = %v, want ErrCommitMismatch", err)
+	}
+}
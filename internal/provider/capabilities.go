@@ -0,0 +1,70 @@
+package provider
+
+import "strings"
+
+// Capabilities describes what a provider and its current model support.
+type Capabilities struct {
+	// Streaming is true if the provider can stream responses.
+	Streaming bool `json:"streaming"`
+
+	// ModelListing is true if the provider implements ModelLister.
+	ModelListing bool `json:"model_listing"`
+
+	// Review is true if the provider supports detailed code reviews (--ai-review).
+	Review bool `json:"review"`
+
+	// ContextWindow is the model's total context size in tokens.
+	ContextWindow int `json:"context_window"`
+}
+
+// CapabilityReporter is an optional interface for providers that can describe their capabilities.
+// Use type assertion to check if a provider supports this: if r, ok := p.(CapabilityReporter); ok { ... }
+type CapabilityReporter interface {
+	// Capabilities returns the capabilities of the provider and its currently configured model.
+	Capabilities() Capabilities
+}
+
+// CapabilitiesOf returns the capabilities of p. Providers that do not
+// implement CapabilityReporter are described from the interfaces they implement.
+func CapabilitiesOf(p Provider) Capabilities {
+	if r, ok := p.(CapabilityReporter); ok {
+		return r.Capabilities()
+	}
+
+	caps := CapabilitiesFor("")
+	_, caps.ModelListing = p.(ModelLister)
+	caps.Review = true
+	return caps
+}
+
+// DefaultContextWindow is used for models with no known context size.
+// It is deliberately conservative so unknown models are not overfilled.
+const DefaultContextWindow = 32000
+
+// modelContextWindows maps model ID prefixes to their context size in tokens.
+var modelContextWindows = map[string]int{
+	// Anthropic models (API IDs and copilot-api IDs)
+	"claude-": 200000,
+
+	// OpenAI models
+	"gpt-5":         400000,
+	"gpt-4.1":       1000000,
+	"gpt-4o":        128000,
+	"gpt-4-turbo":   128000,
+	"gpt-4":         8192,
+	"gpt-3.5-turbo": 16385,
+	"o1":            200000,
+	"o3":            200000,
+	"o4-mini":       200000,
+}
+
+// CapabilitiesFor returns the known capabilities of a model. Only ContextWindow is set;
+// providers fill in the rest. The longest matching prefix wins; unknown or empty
+// models get DefaultContextWindow.
+func CapabilitiesFor(model string) Capabilities {
+	window, ok := longestPrefixMatch(strings.ToLower(model), modelContextWindows)
+	if !ok {
+		window = DefaultContextWindow
+	}
+	return Capabilities{ContextWindow: window}
+}
@@ -0,0 +1,27 @@
+package provider
+
+import "testing"
+
+func TestCapabilitiesFor(t *testing.T) {
+	tests := []struct {
+		model string
+		want  int
+	}{
+		{"claude-sonnet-4-20250514", 200000},
+		{"claude-3.5-sonnet", 200000},
+		{"gpt-4o-mini", 128000},
+		{"gpt-4-turbo", 128000},
+		{"gpt-4", 8192},
+		{"GPT-4", 8192},
+		{"some-local-model", DefaultContextWindow},
+		{"", DefaultContextWindow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.model, func(t *testing.T) {
+			if got := CapabilitiesFor(tt.model).ContextWindow; got != tt.want {
+				t.Errorf("CapabilitiesFor(%q).ContextWindow = %d, want %d", tt.model, got, tt.want)
+			}
+		})
+	}
+}
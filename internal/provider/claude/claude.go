@@ -40,27 +40,68 @@ func New(apiKey, model string) (*Provider, error) {
 	}, nil
 }
 
+// NewUnauthenticated creates a Claude provider without an API key. It reports
+// its name and capabilities but its requests fail, so providers can be described
+// without credentials. If model is empty, DefaultModel is used.
+func NewUnauthenticated(model string) *Provider {
+	if model == "" {
+		model = DefaultModel
+	}
+	return &Provider{
+		client: anthropic.NewClient(option.WithAPIKey("")),
+		model:  anthropic.Model(model),
+	}
+}
+
 // Name returns "claude".
 func (p *Provider) Name() string {
 	return "claude"
 }
 
+// Capabilities returns the provider's capabilities for the configured model.
+// Claude models are not listed.
+func (p *Provider) Capabilities() provider.Capabilities {
+	caps := provider.CapabilitiesFor(string(p.model))
+	caps.Review = true
+	return caps
+}
+
+// Verify checks that the API key is accepted by making a lightweight models request.
+func (p *Provider) Verify(ctx context.Context) error {
+	_, err := p.client.Models.List(ctx, anthropic.ModelListParams{
+		Limit: anthropic.Int(1),
+	})
+	if err != nil {
+		return fmt.Errorf("claude API error: %w", err)
+	}
+	return nil
+}
+
 // SummarizeChanges analyzes a diff and returns a structured summary.
 func (p *Provider) SummarizeChanges(ctx context.Context, req *provider.SummarizeRequest) (*provider.SummarizeResponse, error) {
-	prompt := provider.BuildSummaryPrompt(req)
-
 	maxTokens := req.Options.MaxTokens
 	if maxTokens == 0 {
-		maxTokens = 2048
+		maxTokens = provider.TokenLimitsFor(string(p.model)).Summary
 	}
 
-	resp, err := p.client.Messages.New(ctx, anthropic.MessageNewParams{
+	prompt := provider.BuildSummaryPrompt(req, p.Capabilities(), maxTokens)
+
+	params := anthropic.MessageNewParams{
 		Model:     p.model,
 		MaxTokens: int64(maxTokens),
 		Messages: []anthropic.MessageParam{
 			anthropic.NewUserMessage(anthropic.NewTextBlock(prompt)),
 		},
-	})
+	}
+
+	// Add system prompt if provided
+	if systemPrompt := provider.BuildSummarySystemPrompt(req); systemPrompt != "" {
+		params.System = []anthropic.TextBlockParam{
+			{Text: systemPrompt},
+		}
+	}
+
+	resp, err := p.client.Messages.New(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("claude API error: %w", err)
 	}
@@ -86,7 +127,7 @@ func (p *Provider) OrderFiles(ctx context.Context, req *provider.OrderRequest) (
 
 	resp, err := p.client.Messages.New(ctx, anthropic.MessageNewParams{
 		Model:     p.model,
-		MaxTokens: int64(2048),
+		MaxTokens: int64(provider.TokenLimitsFor(string(p.model)).Order),
 		Messages: []anthropic.MessageParam{
 			anthropic.NewUserMessage(anthropic.NewTextBlock(prompt)),
 		},
@@ -110,13 +151,13 @@ func (p *Provider) OrderFiles(ctx context.Context, req *provider.OrderRequest) (
 
 // ReviewChanges performs a detailed code review of the changes.
 func (p *Provider) ReviewChanges(ctx context.Context, req *provider.ReviewRequest) (*provider.ReviewResponse, error) {
-	prompt := provider.BuildReviewPrompt(req)
-
 	maxTokens := req.Options.MaxTokens
 	if maxTokens == 0 {
-		maxTokens = 8192
+		maxTokens = provider.TokenLimitsFor(string(p.model)).Review
 	}
 
+	prompt := provider.BuildReviewPrompt(req, p.Capabilities(), maxTokens)
+
 	params := anthropic.MessageNewParams{
 		Model:     p.model,
 		MaxTokens: int64(maxTokens),
@@ -37,3 +37,16 @@ func TestNew_NoAPIKey(t *testing.T) {
 		t.Error("expected error for empty API key")
 	}
 }
+
+func TestNewUnauthenticated(t *testing.T) {
+	p := NewUnauthenticated("")
+
+	if string(p.model) != DefaultModel {
+		t.Errorf("model = %q, want %q", p.model, DefaultModel)
+	}
+
+	caps := p.Capabilities()
+	if !caps.Review || caps.ModelListing || caps.ContextWindow != 200000 {
+		t.Errorf("unexpected capabilities: %+v", caps)
+	}
+}
@@ -76,6 +76,15 @@ func (p *Provider) Name() string {
 	return "copilot"
 }
 
+// Capabilities returns the provider's capabilities for the configured model.
+// Models are listed through the proxy.
+func (p *Provider) Capabilities() provider.Capabilities {
+	caps := provider.CapabilitiesFor(p.model)
+	caps.ModelListing = true
+	caps.Review = true
+	return caps
+}
+
 // SetModel updates the model used by this provider.
 func (p *Provider) SetModel(model string) {
 	p.model = model
@@ -113,14 +122,14 @@ type chatResponse struct {
 
 // SummarizeChanges analyzes a diff and returns a structured summary.
 func (p *Provider) SummarizeChanges(ctx context.Context, req *provider.SummarizeRequest) (*provider.SummarizeResponse, error) {
-	prompt := provider.BuildSummaryPrompt(req)
-
 	maxTokens := req.Options.MaxTokens
 	if maxTokens == 0 {
-		maxTokens = 2048
+		maxTokens = provider.TokenLimitsFor(p.model).Summary
 	}
 
-	text, err := p.chat(ctx, prompt, "", maxTokens)
+	prompt := provider.BuildSummaryPrompt(req, p.Capabilities(), maxTokens)
+
+	text, err := p.chat(ctx, prompt, provider.BuildSummarySystemPrompt(req), maxTokens)
 	if err != nil {
 		return nil, err
 	}
@@ -137,7 +146,7 @@ func (p *Provider) SummarizeChanges(ctx context.Context, req *provider.Summarize
 func (p *Provider) OrderFiles(ctx context.Context, req *provider.OrderRequest) (*provider.OrderResponse, error) {
 	prompt := provider.BuildOrderPrompt(req)
 
-	text, err := p.chat(ctx, prompt, "", 2048)
+	text, err := p.chat(ctx, prompt, "", provider.TokenLimitsFor(p.model).Order)
 	if err != nil {
 		return nil, err
 	}
@@ -152,13 +161,13 @@ func (p *Provider) OrderFiles(ctx context.Context, req *provider.OrderRequest) (
 
 // ReviewChanges performs a detailed code review of the changes.
 func (p *Provider) ReviewChanges(ctx context.Context, req *provider.ReviewRequest) (*provider.ReviewResponse, error) {
-	prompt := provider.BuildReviewPrompt(req)
-
 	maxTokens := req.Options.MaxTokens
 	if maxTokens == 0 {
-		maxTokens = 8192
+		maxTokens = provider.TokenLimitsFor(p.model).Review
 	}
 
+	prompt := provider.BuildReviewPrompt(req, p.Capabilities(), maxTokens)
+
 	text, err := p.chat(ctx, prompt, req.SystemPrompt, maxTokens)
 	if err != nil {
 		return nil, err
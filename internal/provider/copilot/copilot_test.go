@@ -321,6 +321,43 @@ func TestSummarizeChanges_WithMaxTokens(t *testing.T) {
 	}
 }
 
+func TestSummarizeChanges_DefaultMaxTokensForModel(t *testing.T) {
+	var receivedMaxTokens int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req chatRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		receivedMaxTokens = req.MaxTokens
+
+		resp := chatResponse{
+			Choices: []struct {
+				Message struct {
+					Content string `json:"content"`
+				} `json:"message"`
+			}{
+				{Message: struct {
+					Content string `json:"content"`
+				}{Content: `{"overview": "Test", "key_changes": []}`}},
+			},
+		}
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	p, _ := New(server.URL, "gpt-4o")
+	_, err := p.SummarizeChanges(context.Background(), &provider.SummarizeRequest{
+		Files:   []git.FileDiff{{Path: "test.go"}},
+		Options: provider.DefaultSummarizeOptions(),
+	})
+
+	if err != nil {
+		t.Fatalf("SummarizeChanges() failed: %v", err)
+	}
+	want := provider.TokenLimitsFor("gpt-4o").Summary
+	if receivedMaxTokens != want {
+		t.Errorf("MaxTokens = %d, want %d", receivedMaxTokens, want)
+	}
+}
+
 func TestListModels(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.URL.Path == "/v1/models" {
@@ -502,6 +539,49 @@ func TestReviewChanges_WithSystemPrompt(t *testing.T) {
 	}
 }
 
+func TestSummarizeChanges_WithSystemPrompt(t *testing.T) {
+	var receivedMessages []chatMessage
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req chatRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		receivedMessages = req.Messages
+
+		resp := chatResponse{
+			Choices: []struct {
+				Message struct {
+					Content string `json:"content"`
+				} `json:"message"`
+			}{
+				{Message: struct {
+					Content string `json:"content"`
+				}{Content: `{"overview": "Summary", "key_changes": []}`}},
+			},
+		}
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	p, _ := New(server.URL, "")
+	_, err := p.SummarizeChanges(context.Background(), &provider.SummarizeRequest{
+		Files:        []git.FileDiff{{Path: "main.go"}},
+		SystemPrompt: "You are a security auditor.",
+	})
+
+	if err != nil {
+		t.Fatalf("SummarizeChanges() failed: %v", err)
+	}
+
+	if len(receivedMessages) != 2 {
+		t.Fatalf("expected 2 messages (system + user), got %d", len(receivedMessages))
+	}
+	if receivedMessages[0].Role != "system" || !strings.HasPrefix(receivedMessages[0].Content, "You are a security auditor.") {
+		t.Errorf("unexpected system message: %+v", receivedMessages[0])
+	}
+	if !strings.Contains(receivedMessages[0].Content, "JSON") {
+		t.Errorf("system message should restate the JSON contract after the persona: %q", receivedMessages[0].Content)
+	}
+}
+
 func TestReviewChanges_WithMaxTokens(t *testing.T) {
 	var receivedMaxTokens int
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
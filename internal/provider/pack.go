@@ -0,0 +1,227 @@
+package provider
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/mwistrand/graft/internal/git"
+)
+
+// charsPerToken approximates how many characters of diff text fit in one token.
+const charsPerToken = 4
+
+// packNoteReserve is the room held back for the note describing what was left out.
+const packNoteReserve = 128
+
+// truncatedNote marks diff text that was cut without regard to file boundaries.
+const truncatedNote = "\n\n... [diff truncated for length] ..."
+
+// PackedDiff is the result of fitting a diff into a character budget.
+type PackedDiff struct {
+	// Diff is the packed diff text, including a note if anything was left out.
+	Diff string
+
+	// Omitted lists the files left out entirely.
+	Omitted []string
+
+	// Shortened lists the files included with only their leading hunks.
+	Shortened []string
+}
+
+// DiffBudget returns how many characters of diff fit in a model's context
+// alongside overhead characters of prompt text and a response of maxTokens tokens.
+// A tenth of the context window is held back to absorb tokenizer variance.
+func DiffBudget(caps Capabilities, overhead, maxTokens int) int {
+	window := caps.ContextWindow
+	if window <= 0 {
+		window = DefaultContextWindow
+	}
+
+	budget := (window-window/10-maxTokens)*charsPerToken - overhead
+	if budget < 0 {
+		return 0
+	}
+	return budget
+}
+
+// PackDiff selects the files and hunks of diff that fit within budget characters.
+// Files are considered in priority order: source before tests, tests before
+// config and docs, and generated files last, with smaller files first within
+// each tier so more of the change fits. A file that does not fit whole keeps as
+// many leading hunks as fit. Included files stay in their original diff order.
+// Text that cannot be parsed as a diff is cut at the budget. Whenever anything
+// is left out, a note saying so is written even if it exceeds the budget.
+func PackDiff(diff string, budget int) PackedDiff {
+	budget = max(budget, 0)
+	if len(diff) <= budget {
+		return PackedDiff{Diff: diff}
+	}
+
+	files, _, err := git.ParsePatch(diff)
+	if err != nil {
+		if budget <= len(truncatedNote) {
+			return PackedDiff{Diff: strings.TrimLeft(truncatedNote, "\n")}
+		}
+		return PackedDiff{Diff: cutText(diff, budget-len(truncatedNote)) + truncatedNote}
+	}
+
+	if budget <= packNoteReserve {
+		var packed PackedDiff
+		for _, f := range files {
+			packed.Omitted = append(packed.Omitted, f.Path)
+		}
+		packed.Diff = strings.TrimLeft(packNote(len(packed.Omitted), 0), "\n")
+		return packed
+	}
+
+	ranked := make([]int, len(files))
+	for i := range ranked {
+		ranked[i] = i
+	}
+	sort.SliceStable(ranked, func(a, b int) bool {
+		fa, fb := files[ranked[a]], files[ranked[b]]
+		if pa, pb := packPriority(fa), packPriority(fb); pa != pb {
+			return pa < pb
+		}
+		return len(fa.Patch) < len(fb.Patch)
+	})
+
+	remaining := budget - packNoteReserve
+	sections := make([]string, len(files))
+	var packed PackedDiff
+	for _, i := range ranked {
+		patch := files[i].Patch
+		if len(patch) <= remaining {
+			sections[i] = patch
+			remaining -= len(patch)
+			continue
+		}
+
+		if partial := leadingHunks(patch, remaining); partial != "" {
+			sections[i] = partial
+			remaining -= len(partial)
+			packed.Shortened = append(packed.Shortened, files[i].Path)
+			continue
+		}
+
+		packed.Omitted = append(packed.Omitted, files[i].Path)
+	}
+
+	var b strings.Builder
+	for _, section := range sections {
+		b.WriteString(section)
+	}
+	b.WriteString(packNote(len(packed.Omitted), len(packed.Shortened)))
+	packed.Diff = b.String()
+
+	return packed
+}
+
+// leadingHunks returns the file header of patch followed by as many of its
+// hunks as fit within limit characters. Returns an empty string if not even
+// the first hunk fits.
+func leadingHunks(patch string, limit int) string {
+	lines := strings.SplitAfter(patch, "\n")
+
+	var b strings.Builder
+	hunks := 0
+	for i := 0; i < len(lines); {
+		// Collect the next header block or hunk
+		end := i + 1
+		for end < len(lines) && !strings.HasPrefix(lines[end], "@@") {
+			end++
+		}
+		chunk := strings.Join(lines[i:end], "")
+		if b.Len()+len(chunk) > limit {
+			break
+		}
+		b.WriteString(chunk)
+		if strings.HasPrefix(lines[i], "@@") {
+			hunks++
+		}
+		i = end
+	}
+
+	if hunks == 0 {
+		return ""
+	}
+	return b.String()
+}
+
+// packNote describes what was left out of a packed diff.
+func packNote(omitted, shortened int) string {
+	if omitted == 0 && shortened == 0 {
+		return ""
+	}
+
+	var parts []string
+	if omitted > 0 {
+		parts = append(parts, fmt.Sprintf("%d %s omitted", omitted, pluralFiles(omitted)))
+	}
+	if shortened > 0 {
+		parts = append(parts, fmt.Sprintf("%d %s shortened", shortened, pluralFiles(shortened)))
+	}
+	return fmt.Sprintf("\n... [diff truncated to fit the context window: %s] ...", strings.Join(parts, ", "))
+}
+
+// pluralFiles returns "file" or "files" for n.
+func pluralFiles(n int) string {
+	if n == 1 {
+		return "file"
+	}
+	return "files"
+}
+
+// packPriority ranks a file's value to a reviewer. Lower values are packed first.
+func packPriority(f git.FileDiff) int {
+	path := strings.ToLower(f.Path)
+	base := path[strings.LastIndex(path, "/")+1:]
+
+	switch {
+	case isGeneratedFile(path, base):
+		return 4
+	case f.Status == git.StatusDeleted,
+		strings.HasSuffix(base, ".md"), strings.HasSuffix(base, ".txt"),
+		strings.HasPrefix(path, "docs/"), strings.Contains(path, "/docs/"):
+		return 3
+	case strings.HasSuffix(base, ".json"), strings.HasSuffix(base, ".yaml"),
+		strings.HasSuffix(base, ".yml"), strings.HasSuffix(base, ".toml"):
+		return 2
+	case strings.Contains(base, "_test."), strings.Contains(base, ".test."),
+		strings.Contains(base, ".spec."), strings.HasPrefix(base, "test_"):
+		return 1
+	default:
+		return 0
+	}
+}
+
+// isGeneratedFile reports whether a path looks like a lockfile, vendored
+// dependency, or generated source, which rarely needs review.
+func isGeneratedFile(path, base string) bool {
+	switch base {
+	case "go.sum", "package-lock.json", "yarn.lock", "pnpm-lock.yaml", "cargo.lock", "poetry.lock", "gemfile.lock":
+		return true
+	}
+	return strings.HasPrefix(path, "vendor/") || strings.Contains(path, "/vendor/") ||
+		strings.HasPrefix(path, "node_modules/") ||
+		strings.HasSuffix(base, ".min.js") || strings.HasSuffix(base, ".pb.go") ||
+		strings.HasSuffix(base, ".snap") || strings.Contains(base, "_generated.") ||
+		strings.Contains(base, ".gen.")
+}
+
+// cutText returns at most limit bytes of text, ending at the last newline
+// within the limit or, if there is none, on a rune boundary.
+func cutText(text string, limit int) string {
+	if limit >= len(text) {
+		return text
+	}
+	if i := strings.LastIndexByte(text[:limit], '\n'); i > 0 {
+		return text[:i]
+	}
+	for limit > 0 && !utf8.RuneStart(text[limit]) {
+		limit--
+	}
+	return text[:limit]
+}
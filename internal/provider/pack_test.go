@@ -0,0 +1,204 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/mwistrand/graft/internal/git"
+)
+
+// fileDiff builds a single-file git diff with one hunk per entry in hunks,
+// each hunk adding lines lines.
+func fileDiff(path string, hunks ...int) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "diff --git a/%s b/%s\n--- a/%s\n+++ b/%s\n", path, path, path, path)
+	start := 1
+	for _, lines := range hunks {
+		fmt.Fprintf(&b, "@@ -%d,0 +%d,%d @@\n", start, start, lines)
+		for i := 0; i < lines; i++ {
+			fmt.Fprintf(&b, "+%s line %d\n", path, start+i)
+		}
+		start += lines + 10
+	}
+	return b.String()
+}
+
+func TestPackDiff_FitsWhole(t *testing.T) {
+	diff := fileDiff("main.go", 3)
+
+	packed := PackDiff(diff, len(diff))
+	if packed.Diff != diff {
+		t.Errorf("diff within budget should be unchanged, got %q", packed.Diff)
+	}
+	if len(packed.Omitted) != 0 || len(packed.Shortened) != 0 {
+		t.Errorf("nothing should be left out: %+v", packed)
+	}
+}
+
+func TestPackDiff_RespectsBudget(t *testing.T) {
+	diff := fileDiff("internal/api/handler.go", 40) +
+		fileDiff("internal/api/handler_test.go", 40) +
+		fileDiff("docs/api.md", 40) +
+		fileDiff("go.sum", 40)
+
+	for _, budget := range []int{200, 1000, 2500, 4000, len(diff) - 1} {
+		t.Run(fmt.Sprint(budget), func(t *testing.T) {
+			packed := PackDiff(diff, budget)
+			if len(packed.Diff) > budget {
+				t.Errorf("packed diff is %d chars, budget %d", len(packed.Diff), budget)
+			}
+		})
+	}
+}
+
+func TestPackDiff_PrioritizesHighValueFiles(t *testing.T) {
+	source := fileDiff("internal/api/handler.go", 20)
+	test := fileDiff("internal/api/handler_test.go", 20)
+	docs := fileDiff("README.md", 20)
+	lock := fileDiff("package-lock.json", 20)
+
+	// Generated and docs files come first in the diff but rank last
+	diff := lock + docs + test + source
+
+	// Room for the source and test files only
+	packed := PackDiff(diff, len(source)+len(test)+packNoteReserve)
+
+	if !strings.Contains(packed.Diff, "internal/api/handler.go line 1\n") {
+		t.Error("source file should be included")
+	}
+	if !strings.Contains(packed.Diff, "handler_test.go line 1\n") {
+		t.Error("test file should be included")
+	}
+	if strings.Contains(packed.Diff, "README.md line") || strings.Contains(packed.Diff, "package-lock.json line") {
+		t.Error("low-value files should be left out")
+	}
+	if len(packed.Omitted) != 2 {
+		t.Errorf("expected 2 omitted files, got %v", packed.Omitted)
+	}
+	if !strings.Contains(packed.Diff, "2 files omitted") {
+		t.Errorf("packed diff should note omitted files: %q", packed.Diff)
+	}
+
+	// Included files keep their original order
+	if strings.Index(packed.Diff, "handler_test.go") > strings.Index(packed.Diff, "diff --git a/internal/api/handler.go") {
+		t.Error("included files should stay in diff order")
+	}
+}
+
+func TestPackDiff_ShortensLargeFile(t *testing.T) {
+	small := fileDiff("internal/small.go", 2)
+	large := fileDiff("internal/large.go", 10, 10, 10)
+	diff := large + small
+
+	// Room for the small file plus roughly one hunk of the large one
+	budget := len(small) + len(large)/2 + packNoteReserve
+	packed := PackDiff(diff, budget)
+
+	if len(packed.Diff) > budget {
+		t.Errorf("packed diff is %d chars, budget %d", len(packed.Diff), budget)
+	}
+	if !strings.Contains(packed.Diff, "internal/small.go line 1\n") {
+		t.Error("smaller file should be included whole")
+	}
+	if !strings.Contains(packed.Diff, "internal/large.go line 1\n") {
+		t.Error("leading hunk of the large file should be included")
+	}
+	if strings.Contains(packed.Diff, "internal/large.go line 50\n") {
+		t.Error("trailing hunk of the large file should be dropped")
+	}
+	if len(packed.Shortened) != 1 || packed.Shortened[0] != "internal/large.go" {
+		t.Errorf("expected large.go to be shortened, got %v", packed.Shortened)
+	}
+}
+
+func TestPackDiff_NoRoom(t *testing.T) {
+	diff := fileDiff("main.go", 10) + fileDiff("util.go", 10)
+
+	for _, budget := range []int{packNoteReserve, 0, -500} {
+		t.Run(fmt.Sprint(budget), func(t *testing.T) {
+			packed := PackDiff(diff, budget)
+			if len(packed.Omitted) != 2 {
+				t.Errorf("expected both files omitted, got %v", packed.Omitted)
+			}
+			if !strings.Contains(packed.Diff, "2 files omitted") {
+				t.Errorf("packed diff should still note omitted files: %q", packed.Diff)
+			}
+		})
+	}
+
+	if packed := PackDiff("not a diff", -1); !strings.Contains(packed.Diff, "diff truncated for length") {
+		t.Errorf("raw text with no room should still note truncation: %q", packed.Diff)
+	}
+}
+
+func TestPackDiff_UnparseableText(t *testing.T) {
+	packed := PackDiff(strings.Repeat("x", 1000), 500)
+
+	if len(packed.Diff) != 500 {
+		t.Errorf("expected raw text cut to 500 chars, got %d", len(packed.Diff))
+	}
+	if !strings.HasSuffix(packed.Diff, "... [diff truncated for length] ...") {
+		t.Error("raw text should end with truncation note")
+	}
+}
+
+func TestPackDiff_UnparseableTextCutsCleanly(t *testing.T) {
+	// Multi-byte runes are never split
+	packed := PackDiff(strings.Repeat("é", 500), 101)
+	if !utf8.ValidString(packed.Diff) {
+		t.Error("truncated text should be valid UTF-8")
+	}
+
+	// Text is cut at the last newline that fits
+	text := strings.Repeat("line of text\n", 100)
+	packed = PackDiff(text, 200)
+	body := strings.TrimSuffix(packed.Diff, truncatedNote)
+	if !strings.HasSuffix(body, "line of text") || len(packed.Diff) > 200 {
+		t.Errorf("expected whole lines within budget, got %q", packed.Diff)
+	}
+}
+
+func TestDiffBudget(t *testing.T) {
+	caps := Capabilities{ContextWindow: 10000}
+
+	// 10000 tokens less a 1000 token margin and 2000 for the response
+	if got := DiffBudget(caps, 0, 2000); got != 28000 {
+		t.Errorf("DiffBudget() = %d, want 28000", got)
+	}
+	if got := DiffBudget(caps, 1000, 2000); got != 27000 {
+		t.Errorf("DiffBudget() with overhead = %d, want 27000", got)
+	}
+	if got := DiffBudget(caps, 0, 20000); got != 0 {
+		t.Errorf("DiffBudget() should not go negative, got %d", got)
+	}
+	if got, want := DiffBudget(Capabilities{}, 0, 0), DiffBudget(Capabilities{ContextWindow: DefaultContextWindow}, 0, 0); got != want {
+		t.Errorf("zero context window should use the default: %d != %d", got, want)
+	}
+}
+
+func TestPackPriority(t *testing.T) {
+	tests := []struct {
+		file git.FileDiff
+		want int
+	}{
+		{git.FileDiff{Path: "internal/api/handler.go"}, 0},
+		{git.FileDiff{Path: "internal/api/handler_test.go"}, 1},
+		{git.FileDiff{Path: "src/app.spec.ts"}, 1},
+		{git.FileDiff{Path: "config/app.yaml"}, 2},
+		{git.FileDiff{Path: "README.md"}, 3},
+		{git.FileDiff{Path: "internal/old.go", Status: git.StatusDeleted}, 3},
+		{git.FileDiff{Path: "go.sum"}, 4},
+		{git.FileDiff{Path: "vendor/github.com/x/y.go"}, 4},
+		{git.FileDiff{Path: "api/service.pb.go"}, 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.file.Path, func(t *testing.T) {
+			if got := packPriority(tt.file); got != tt.want {
+				t.Errorf("packPriority(%q) = %d, want %d", tt.file.Path, got, tt.want)
+			}
+		})
+	}
+}
@@ -6,14 +6,43 @@ import (
 	"strings"
 )
 
+// summaryJSONContract follows the persona in the summary system prompt, so a
+// persona changes what the analysis covers but not the response format.
+const summaryJSONContract = `
+
+Whatever your focus, respond only with the JSON object described in the request. Express your findings through its fields, and return no text outside it.`
+
+// BuildSummarySystemPrompt returns the system prompt for change summarization:
+// the request's persona followed by the JSON response contract.
+// Returns an empty string if the request has no persona.
+func BuildSummarySystemPrompt(req *SummarizeRequest) string {
+	if req.SystemPrompt == "" {
+		return ""
+	}
+	return strings.TrimRight(req.SystemPrompt, "\n") + summaryJSONContract
+}
+
 // BuildSummaryPrompt constructs the prompt for change summarization.
-func BuildSummaryPrompt(req *SummarizeRequest) string {
+// The diff is packed to fit the model's context window alongside a response of maxTokens.
+func BuildSummaryPrompt(req *SummarizeRequest, caps Capabilities, maxTokens int) string {
 	var b strings.Builder
 
 	b.WriteString(`You are an expert code reviewer analyzing a pull request. Review the following diff and commit messages to provide a concise, actionable summary.
 
 `)
 
+	// Add pull request context if provided
+	if req.Title != "" || req.Description != "" {
+		b.WriteString("## Pull Request\n")
+		if req.Title != "" {
+			b.WriteString(fmt.Sprintf("Title: %s\n", req.Title))
+		}
+		if req.Description != "" {
+			b.WriteString("\n" + req.Description + "\n")
+		}
+		b.WriteString("\n")
+	}
+
 	// Add commits section
 	if len(req.Commits) > 0 {
 		b.WriteString("## Commits\n")
@@ -38,24 +67,14 @@ func BuildSummaryPrompt(req *SummarizeRequest) string {
 	}
 	b.WriteString("\n")
 
-	// Add diff content if available (truncated for large diffs)
-	if req.FullDiff != "" {
-		diff := req.FullDiff
-		const maxDiffLen = 50000
-		if len(diff) > maxDiffLen {
-			diff = diff[:maxDiffLen] + "\n\n... [diff truncated for length] ..."
-		}
-		b.WriteString("## Diff Content\n```diff\n")
-		b.WriteString(diff)
-		b.WriteString("\n```\n\n")
-	}
+	var tail strings.Builder
 
 	// Add focus instruction if specified
 	if req.Options.Focus != "" {
-		b.WriteString(fmt.Sprintf("Focus your analysis on: %s\n\n", req.Options.Focus))
+		tail.WriteString(fmt.Sprintf("Focus your analysis on: %s\n\n", req.Options.Focus))
 	}
 
-	b.WriteString(`---
+	tail.WriteString(`---
 
 Respond with a JSON object in this exact format:
 {
@@ -86,9 +105,29 @@ Focus on:
 
 Return ONLY valid JSON, no additional text.`)
 
+	// Add diff content if available (packed to fit the context window)
+	overhead := b.Len() + tail.Len() + len(BuildSummarySystemPrompt(req))
+	writeDiffSection(&b, req.FullDiff, DiffBudget(caps, overhead, maxTokens))
+	b.WriteString(tail.String())
+
 	return b.String()
 }
 
+// writeDiffSection writes the diff content section, packing diff into budget characters.
+// Nothing is written if diff is empty.
+func writeDiffSection(b *strings.Builder, diff string, budget int) {
+	if diff == "" {
+		return
+	}
+
+	const header, footer = "## Diff Content\n```diff\n", "\n```\n\n"
+	budget = max(budget-len(header)-len(footer), 0)
+
+	b.WriteString(header)
+	b.WriteString(PackDiff(diff, budget).Diff)
+	b.WriteString(footer)
+}
+
 // BuildOrderPrompt constructs the prompt for file ordering.
 func BuildOrderPrompt(req *OrderRequest) string {
 	var b strings.Builder
@@ -256,8 +295,10 @@ func ExtractJSON(text string) string {
 }
 
 // BuildReviewPrompt constructs the user prompt for a detailed code review.
-// The system prompt should be passed separately to the AI provider.
-func BuildReviewPrompt(req *ReviewRequest) string {
+// The system prompt should be passed separately to the AI provider. The diff is
+// packed to fit the model's context window alongside the system prompt and a
+// response of maxTokens.
+func BuildReviewPrompt(req *ReviewRequest, caps Capabilities, maxTokens int) string {
 	var b strings.Builder
 
 	b.WriteString(`Please review the following code changes and provide a detailed, constructive code review.
@@ -288,19 +329,7 @@ func BuildReviewPrompt(req *ReviewRequest) string {
 	}
 	b.WriteString("\n")
 
-	// Add diff content
-	if req.FullDiff != "" {
-		diff := req.FullDiff
-		const maxDiffLen = 80000
-		if len(diff) > maxDiffLen {
-			diff = diff[:maxDiffLen] + "\n\n... [diff truncated for length] ..."
-		}
-		b.WriteString("## Diff Content\n```diff\n")
-		b.WriteString(diff)
-		b.WriteString("\n```\n\n")
-	}
-
-	b.WriteString(`---
+	tail := `---
 
 Please provide your review in markdown format. Include:
 1. **Executive Summary**: Brief overview of the changes and their impact
@@ -310,7 +339,12 @@ Please provide your review in markdown format. Include:
 5. **Suggestions**: Specific, actionable recommendations for improvement
 6. **Questions**: Any clarifying questions for the author
 
-Focus on being constructive and educational. Prioritize significant issues over minor stylistic preferences.`)
+Focus on being constructive and educational. Prioritize significant issues over minor stylistic preferences.`
+
+	// Add diff content (packed to fit the context window)
+	overhead := b.Len() + len(tail) + len(req.SystemPrompt)
+	writeDiffSection(&b, req.FullDiff, DiffBudget(caps, overhead, maxTokens))
+	b.WriteString(tail)
 
 	return b.String()
 }
@@ -2,7 +2,9 @@
 package prompts
 
 import (
-	_ "embed"
+	"embed"
+	"fmt"
+	"strings"
 )
 
 // DefaultCodeReviewerPrompt is the default system prompt for AI code reviews.
@@ -10,3 +12,38 @@ import (
 //
 //go:embed code-reviewer.md
 var DefaultCodeReviewerPrompt string
+
+//go:embed personas/*.md
+var personaFiles embed.FS
+
+// Personas lists the built-in review personas selectable with --persona.
+var Personas = []string{
+	"security-auditor",
+	"performance-tuner",
+	"junior-friendly-explainer",
+	"architecture-reviewer",
+}
+
+// PersonaPrompt returns the embedded system prompt for a review persona.
+// Returns an error listing the available personas if name is unknown.
+func PersonaPrompt(name string) (string, error) {
+	if !IsPersona(name) {
+		return "", fmt.Errorf("unknown persona %q; available: %s", name, strings.Join(Personas, ", "))
+	}
+
+	data, err := personaFiles.ReadFile("personas/" + name + ".md")
+	if err != nil {
+		return "", fmt.Errorf("reading persona %q: %w", name, err)
+	}
+	return string(data), nil
+}
+
+// IsPersona reports whether name is a built-in review persona.
+func IsPersona(name string) bool {
+	for _, p := range Personas {
+		if p == name {
+			return true
+		}
+	}
+	return false
+}
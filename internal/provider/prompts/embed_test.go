@@ -0,0 +1,47 @@
+package prompts
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPersonaPrompt_Distinct(t *testing.T) {
+	seen := map[string]string{
+		DefaultCodeReviewerPrompt: "default",
+	}
+
+	for _, name := range Personas {
+		t.Run(name, func(t *testing.T) {
+			prompt, err := PersonaPrompt(name)
+			if err != nil {
+				t.Fatalf("PersonaPrompt(%q) failed: %v", name, err)
+			}
+			if strings.TrimSpace(prompt) == "" {
+				t.Fatal("persona prompt should not be empty")
+			}
+			if other, ok := seen[prompt]; ok {
+				t.Errorf("persona %q has the same prompt as %q", name, other)
+			}
+			seen[prompt] = name
+		})
+	}
+}
+
+func TestPersonaPrompt_Unknown(t *testing.T) {
+	_, err := PersonaPrompt("pirate")
+	if err == nil {
+		t.Fatal("expected error for unknown persona")
+	}
+	if !strings.Contains(err.Error(), "security-auditor") {
+		t.Errorf("error should list available personas: %v", err)
+	}
+}
+
+func TestIsPersona(t *testing.T) {
+	if !IsPersona("architecture-reviewer") {
+		t.Error("architecture-reviewer should be a persona")
+	}
+	if IsPersona("") || IsPersona("code-reviewer") {
+		t.Error("only built-in personas should match")
+	}
+}
@@ -93,7 +93,7 @@ func TestBuildSummaryPrompt(t *testing.T) {
 		FullDiff: "+line1\n-line2",
 	}
 
-	prompt := BuildSummaryPrompt(req)
+	prompt := BuildSummaryPrompt(req, Capabilities{}, 0)
 
 	// Check that key elements are present
 	if !strings.Contains(prompt, "main.go") {
@@ -126,7 +126,7 @@ func TestBuildSummaryPrompt_WithFocus(t *testing.T) {
 		},
 	}
 
-	prompt := BuildSummaryPrompt(req)
+	prompt := BuildSummaryPrompt(req, Capabilities{}, 0)
 
 	if !strings.Contains(prompt, "security") {
 		t.Error("prompt should contain focus area")
@@ -138,7 +138,7 @@ func TestBuildSummaryPrompt_EdgeCases(t *testing.T) {
 		req := &SummarizeRequest{
 			Files: []git.FileDiff{{Path: "main.go"}},
 		}
-		prompt := BuildSummaryPrompt(req)
+		prompt := BuildSummaryPrompt(req, Capabilities{}, 0)
 		if strings.Contains(prompt, "## Commits") {
 			t.Error("prompt should not have Commits section when commits are empty")
 		}
@@ -151,7 +151,7 @@ func TestBuildSummaryPrompt_EdgeCases(t *testing.T) {
 				{ShortHash: "abc", Author: "Test", Subject: "Subject", Body: "Detailed body"},
 			},
 		}
-		prompt := BuildSummaryPrompt(req)
+		prompt := BuildSummaryPrompt(req, Capabilities{}, 0)
 		if !strings.Contains(prompt, "Detailed body") {
 			t.Error("prompt should include commit body")
 		}
@@ -161,7 +161,7 @@ func TestBuildSummaryPrompt_EdgeCases(t *testing.T) {
 		req := &SummarizeRequest{
 			Files: []git.FileDiff{{Path: "new.go", OldPath: "old.go", Status: git.StatusRenamed}},
 		}
-		prompt := BuildSummaryPrompt(req)
+		prompt := BuildSummaryPrompt(req, Capabilities{}, 0)
 		if !strings.Contains(prompt, "old.go") {
 			t.Error("prompt should include old path for renamed files")
 		}
@@ -172,28 +172,101 @@ func TestBuildSummaryPrompt_EdgeCases(t *testing.T) {
 			Files:   []git.FileDiff{{Path: "main.go"}},
 			Options: SummarizeOptions{Focus: "security implications"},
 		}
-		prompt := BuildSummaryPrompt(req)
+		prompt := BuildSummaryPrompt(req, Capabilities{}, 0)
 		if !strings.Contains(prompt, "security implications") {
 			t.Error("prompt should include focus area")
 		}
 	})
 
+	t.Run("pull request metadata", func(t *testing.T) {
+		req := &SummarizeRequest{
+			Files:       []git.FileDiff{{Path: "main.go"}},
+			Title:       "Add retry logic",
+			Description: "Retries failed uploads with backoff.",
+		}
+		prompt := BuildSummaryPrompt(req, Capabilities{}, 0)
+		if !strings.Contains(prompt, "## Pull Request") {
+			t.Error("prompt should have a Pull Request section")
+		}
+		if !strings.Contains(prompt, "Title: Add retry logic") {
+			t.Error("prompt should include the title")
+		}
+		if !strings.Contains(prompt, "Retries failed uploads with backoff.") {
+			t.Error("prompt should include the description")
+		}
+	})
+
+	t.Run("no pull request metadata", func(t *testing.T) {
+		req := &SummarizeRequest{
+			Files: []git.FileDiff{{Path: "main.go"}},
+		}
+		prompt := BuildSummaryPrompt(req, Capabilities{}, 0)
+		if strings.Contains(prompt, "## Pull Request") {
+			t.Error("prompt should not have a Pull Request section without metadata")
+		}
+	})
+
 	t.Run("large diff truncation", func(t *testing.T) {
 		largeDiff := strings.Repeat("x", 60000)
 		req := &SummarizeRequest{
 			Files:    []git.FileDiff{{Path: "huge.go"}},
 			FullDiff: largeDiff,
 		}
-		prompt := BuildSummaryPrompt(req)
+		caps := Capabilities{ContextWindow: 8000}
+		prompt := BuildSummaryPrompt(req, caps, 1000)
 		if !strings.Contains(prompt, "... [diff truncated for length] ...") {
 			t.Error("large diff should be truncated")
 		}
-		if strings.Contains(prompt, strings.Repeat("x", 50001)) {
-			t.Error("prompt should not contain more than 50000 chars of diff")
+		if limit := DiffBudget(caps, 0, 1000); len(prompt) > limit {
+			t.Errorf("prompt length %d exceeds context budget %d", len(prompt), limit)
 		}
 	})
 }
 
+func TestBuildSummaryPrompt_SystemPromptCountsAgainstBudget(t *testing.T) {
+	req := &SummarizeRequest{
+		Files:        []git.FileDiff{{Path: "huge.go"}},
+		FullDiff:     strings.Repeat("x", 100000),
+		SystemPrompt: strings.Repeat("s", 8000),
+	}
+	caps := Capabilities{ContextWindow: 16000}
+	prompt := BuildSummaryPrompt(req, caps, 2000)
+
+	if !strings.Contains(prompt, "... [diff truncated for length] ...") {
+		t.Error("large diff should be truncated")
+	}
+	if limit := DiffBudget(caps, len(BuildSummarySystemPrompt(req)), 2000); len(prompt) > limit {
+		t.Errorf("prompt length %d exceeds context budget %d left after the system prompt", len(prompt), limit)
+	}
+}
+
+func TestBuildSummarySystemPrompt(t *testing.T) {
+	if got := BuildSummarySystemPrompt(&SummarizeRequest{}); got != "" {
+		t.Errorf("BuildSummarySystemPrompt() without a persona = %q, want empty", got)
+	}
+
+	got := BuildSummarySystemPrompt(&SummarizeRequest{SystemPrompt: "You are a security auditor.\n"})
+	if !strings.HasPrefix(got, "You are a security auditor.") {
+		t.Errorf("system prompt should start with the persona, got %q", got)
+	}
+	if !strings.HasSuffix(got, summaryJSONContract) {
+		t.Errorf("system prompt should end with the JSON contract, got %q", got)
+	}
+}
+
+func TestBuildSummaryPrompt_NoRoomForDiff(t *testing.T) {
+	req := &SummarizeRequest{
+		Files:    []git.FileDiff{{Path: "main.go"}},
+		FullDiff: "diff --git a/main.go b/main.go\n--- a/main.go\n+++ b/main.go\n@@ -1,0 +1,1 @@\n+package main\n",
+	}
+
+	// The response budget alone fills the context window
+	prompt := BuildSummaryPrompt(req, Capabilities{ContextWindow: 1000}, 1000)
+	if !strings.Contains(prompt, "1 file omitted") {
+		t.Errorf("prompt should note the omitted file instead of an empty diff block:\n%s", prompt)
+	}
+}
+
 func TestBuildOrderPrompt(t *testing.T) {
 	req := &OrderRequest{
 		Files: []git.FileDiff{
@@ -339,7 +412,7 @@ func TestBuildReviewPrompt(t *testing.T) {
 		FullDiff: "+line1\n-line2",
 	}
 
-	prompt := BuildReviewPrompt(req)
+	prompt := BuildReviewPrompt(req, Capabilities{}, 0)
 
 	// Check that key elements are present
 	if !strings.Contains(prompt, "main.go") {
@@ -368,16 +441,19 @@ func TestBuildReviewPrompt(t *testing.T) {
 func TestBuildReviewPrompt_LargeDiffTruncation(t *testing.T) {
 	largeDiff := strings.Repeat("x", 100000)
 	req := &ReviewRequest{
-		Files:    []git.FileDiff{{Path: "huge.go"}},
-		FullDiff: largeDiff,
+		Files:        []git.FileDiff{{Path: "huge.go"}},
+		FullDiff:     largeDiff,
+		SystemPrompt: strings.Repeat("s", 2000),
 	}
-	prompt := BuildReviewPrompt(req)
+	caps := Capabilities{ContextWindow: 16000}
+	prompt := BuildReviewPrompt(req, caps, 4000)
 
 	if !strings.Contains(prompt, "... [diff truncated for length] ...") {
 		t.Error("large diff should be truncated")
 	}
-	if strings.Contains(prompt, strings.Repeat("x", 80001)) {
-		t.Error("prompt should not contain more than 80000 chars of diff")
+	// The system prompt shares the context with the user prompt
+	if limit := DiffBudget(caps, len(req.SystemPrompt), 4000); len(prompt) > limit {
+		t.Errorf("prompt length %d exceeds context budget %d", len(prompt), limit)
 	}
 }
 
@@ -386,7 +462,7 @@ func TestBuildReviewPrompt_EdgeCases(t *testing.T) {
 		req := &ReviewRequest{
 			Files: []git.FileDiff{{Path: "main.go"}},
 		}
-		prompt := BuildReviewPrompt(req)
+		prompt := BuildReviewPrompt(req, Capabilities{}, 0)
 		if strings.Contains(prompt, "## Commits") {
 			t.Error("prompt should not have Commits section when commits are empty")
 		}
@@ -396,7 +472,7 @@ func TestBuildReviewPrompt_EdgeCases(t *testing.T) {
 		req := &ReviewRequest{
 			Files: []git.FileDiff{{Path: "new.go", OldPath: "old.go", Status: git.StatusRenamed}},
 		}
-		prompt := BuildReviewPrompt(req)
+		prompt := BuildReviewPrompt(req, Capabilities{}, 0)
 		if !strings.Contains(prompt, "old.go") {
 			t.Error("prompt should include old path for renamed files")
 		}
@@ -406,7 +482,7 @@ func TestBuildReviewPrompt_EdgeCases(t *testing.T) {
 		req := &ReviewRequest{
 			Files: []git.FileDiff{{Path: "main.go"}},
 		}
-		prompt := BuildReviewPrompt(req)
+		prompt := BuildReviewPrompt(req, Capabilities{}, 0)
 		if strings.Contains(prompt, "## Diff Content") {
 			t.Error("prompt should not have Diff Content section when diff is empty")
 		}
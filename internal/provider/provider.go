@@ -36,6 +36,15 @@ type SummarizeRequest struct {
 	// FullDiff contains the complete diff content for analysis.
 	FullDiff string
 
+	// Title is the pull request title, if known (optional).
+	Title string
+
+	// Description is the pull request description, if known (optional).
+	Description string
+
+	// SystemPrompt sets the reviewer persona for the summary (optional).
+	SystemPrompt string
+
 	// Options allows customizing summarization behavior.
 	Options SummarizeOptions
 }
@@ -43,6 +52,7 @@ type SummarizeRequest struct {
 // SummarizeOptions allows customizing summarization behavior.
 type SummarizeOptions struct {
 	// MaxTokens limits the response length.
+	// Zero uses the model's default from TokenLimitsFor.
 	MaxTokens int
 
 	// Temperature controls response randomness (0.0-1.0).
@@ -182,9 +192,9 @@ type ModelSelector interface {
 }
 
 // DefaultSummarizeOptions returns sensible defaults for summarization.
+// MaxTokens is left unset so each provider applies its model's budget.
 func DefaultSummarizeOptions() SummarizeOptions {
 	return SummarizeOptions{
-		MaxTokens:   2048,
 		Temperature: 0.3,
 	}
 }
@@ -210,6 +220,7 @@ type ReviewRequest struct {
 // ReviewOptions allows customizing review behavior.
 type ReviewOptions struct {
 	// MaxTokens limits the response length.
+	// Zero uses the model's default from TokenLimitsFor.
 	MaxTokens int
 }
 
@@ -220,8 +231,7 @@ type ReviewResponse struct {
 }
 
 // DefaultReviewOptions returns sensible defaults for reviews.
+// MaxTokens is left unset so each provider applies its model's budget.
 func DefaultReviewOptions() ReviewOptions {
-	return ReviewOptions{
-		MaxTokens: 8192,
-	}
+	return ReviewOptions{}
 }
@@ -7,8 +7,9 @@ import (
 func TestDefaultSummarizeOptions(t *testing.T) {
 	opts := DefaultSummarizeOptions()
 
-	if opts.MaxTokens != 2048 {
-		t.Errorf("MaxTokens = %d, want 2048", opts.MaxTokens)
+	// Zero defers to the model's default budget
+	if opts.MaxTokens != 0 {
+		t.Errorf("MaxTokens = %d, want 0", opts.MaxTokens)
 	}
 
 	if opts.Temperature != 0.3 {
@@ -6,6 +6,18 @@ import (
 	"sync"
 )
 
+// ProviderInfo describes a registered provider.
+type ProviderInfo struct {
+	// Name is the provider identifier accepted by --provider.
+	Name string `json:"name"`
+
+	// Default is true for the registry's default provider.
+	Default bool `json:"default"`
+
+	// Capabilities describes what the provider supports.
+	Capabilities Capabilities `json:"capabilities"`
+}
+
 // Registry manages available AI providers.
 type Registry struct {
 	mu        sync.RWMutex
@@ -102,3 +114,20 @@ func (r *Registry) DefaultName() string {
 	defer r.mu.RUnlock()
 	return r.defaultID
 }
+
+// Describe returns information about each registered provider, sorted by name.
+func (r *Registry) Describe() []ProviderInfo {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	names := r.availableNames()
+	infos := make([]ProviderInfo, len(names))
+	for i, name := range names {
+		infos[i] = ProviderInfo{
+			Name:         name,
+			Default:      name == r.defaultID,
+			Capabilities: CapabilitiesOf(r.providers[name]),
+		}
+	}
+	return infos
+}
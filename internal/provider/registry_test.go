@@ -157,3 +157,56 @@ func TestRegistryEmpty(t *testing.T) {
 		t.Error("expected error for empty registry")
 	}
 }
+
+// listingProvider implements ModelLister but not CapabilityReporter.
+type listingProvider struct {
+	testProvider
+}
+
+func (p *listingProvider) ListModels(ctx context.Context) ([]ModelInfo, error) {
+	return nil, nil
+}
+
+// reportingProvider implements CapabilityReporter.
+type reportingProvider struct {
+	testProvider
+}
+
+func (p *reportingProvider) Capabilities() Capabilities {
+	return Capabilities{Streaming: true, ContextWindow: 1000}
+}
+
+func TestRegistryDescribe(t *testing.T) {
+	r := NewRegistry("lister")
+	r.Register(&reportingProvider{testProvider{name: "reporter"}})
+	r.Register(&listingProvider{testProvider{name: "lister"}})
+	r.Register(&testProvider{name: "basic"})
+
+	infos := r.Describe()
+	if len(infos) != 3 {
+		t.Fatalf("expected 3 providers, got %d", len(infos))
+	}
+
+	// Sorted by name
+	if infos[0].Name != "basic" || infos[1].Name != "lister" || infos[2].Name != "reporter" {
+		t.Errorf("unexpected order: %+v", infos)
+	}
+
+	if infos[0].Default || !infos[1].Default || infos[2].Default {
+		t.Error("only the default provider should be marked default")
+	}
+
+	// Described from the interfaces it implements
+	basic := infos[0].Capabilities
+	if basic.ModelListing || !basic.Review || basic.ContextWindow != DefaultContextWindow {
+		t.Errorf("unexpected basic capabilities: %+v", basic)
+	}
+	if !infos[1].Capabilities.ModelListing {
+		t.Error("ModelLister should report model listing")
+	}
+
+	// Self-reported capabilities are used as-is
+	if got := infos[2].Capabilities; got != (Capabilities{Streaming: true, ContextWindow: 1000}) {
+		t.Errorf("unexpected reported capabilities: %+v", got)
+	}
+}
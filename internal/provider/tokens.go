@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"strings"
+	"sync"
+)
+
+// TokenLimits holds the default maximum response tokens for each request type.
+type TokenLimits struct {
+	// Summary is the budget for SummarizeChanges.
+	Summary int
+
+	// Order is the budget for OrderFiles.
+	Order int
+
+	// Review is the budget for ReviewChanges.
+	Review int
+}
+
+// DefaultTokenLimits is used for models with no known limits.
+var DefaultTokenLimits = TokenLimits{Summary: 2048, Order: 2048, Review: 8192}
+
+// modelTokenLimits maps model ID prefixes to their default budgets.
+// Budgets stay within each model family's maximum output size.
+var modelTokenLimits = map[string]TokenLimits{
+	// Anthropic models (API IDs and copilot-api IDs)
+	"claude-opus-4":     {Summary: 4096, Order: 4096, Review: 16384},
+	"claude-sonnet-4":   {Summary: 4096, Order: 4096, Review: 16384},
+	"claude-3-7-sonnet": {Summary: 4096, Order: 4096, Review: 16384},
+	"claude-3.7-sonnet": {Summary: 4096, Order: 4096, Review: 16384},
+	"claude-3-5":        {Summary: 2048, Order: 2048, Review: 8192},
+	"claude-3.5":        {Summary: 2048, Order: 2048, Review: 8192},
+	"claude-3-opus":     {Summary: 2048, Order: 2048, Review: 4096},
+	"claude-3-haiku":    {Summary: 2048, Order: 2048, Review: 4096},
+
+	// OpenAI models
+	"gpt-5":         {Summary: 4096, Order: 4096, Review: 16384},
+	"gpt-4.1":       {Summary: 4096, Order: 4096, Review: 16384},
+	"gpt-4o":        {Summary: 4096, Order: 4096, Review: 16384},
+	"gpt-4":         {Summary: 2048, Order: 2048, Review: 4096},
+	"gpt-3.5-turbo": {Summary: 1024, Order: 1024, Review: 4096},
+}
+
+var (
+	tokenOverridesMu sync.RWMutex
+	tokenOverrides   = map[string]TokenLimits{}
+)
+
+// SetTokenLimits overrides the default budgets for models whose ID starts with
+// prefix; an empty prefix applies to every model. Zero fields keep the built-in
+// budget. Overrides take precedence over the built-in table.
+func SetTokenLimits(prefix string, limits TokenLimits) {
+	tokenOverridesMu.Lock()
+	defer tokenOverridesMu.Unlock()
+	tokenOverrides[prefix] = limits
+}
+
+// ResetTokenLimits removes all overrides set with SetTokenLimits.
+func ResetTokenLimits() {
+	tokenOverridesMu.Lock()
+	defer tokenOverridesMu.Unlock()
+	tokenOverrides = map[string]TokenLimits{}
+}
+
+// TokenLimitsFor returns the default budgets for a model.
+// The longest matching prefix wins, checking overrides before the built-in table.
+// Unknown or empty models get DefaultTokenLimits.
+// Providers only consult it when the request's Options.MaxTokens is 0.
+func TokenLimitsFor(model string) TokenLimits {
+	model = strings.ToLower(model)
+
+	limits, ok := longestPrefixMatch(model, modelTokenLimits)
+	if !ok {
+		limits = DefaultTokenLimits
+	}
+
+	tokenOverridesMu.RLock()
+	override, ok := longestPrefixMatch(model, tokenOverrides)
+	tokenOverridesMu.RUnlock()
+	if ok {
+		if override.Summary > 0 {
+			limits.Summary = override.Summary
+		}
+		if override.Order > 0 {
+			limits.Order = override.Order
+		}
+		if override.Review > 0 {
+			limits.Review = override.Review
+		}
+	}
+	return limits
+}
+
+// longestPrefixMatch finds the entry with the longest key that prefixes model.
+func longestPrefixMatch[T any](model string, table map[string]T) (T, bool) {
+	var best string
+	var found bool
+	for prefix := range table {
+		if strings.HasPrefix(model, strings.ToLower(prefix)) && (!found || len(prefix) > len(best)) {
+			best = prefix
+			found = true
+		}
+	}
+	if !found {
+		var zero T
+		return zero, false
+	}
+	return table[best], true
+}
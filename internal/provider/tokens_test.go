@@ -0,0 +1,63 @@
+package provider
+
+import "testing"
+
+func TestTokenLimitsFor(t *testing.T) {
+	tests := []struct {
+		model string
+		want  TokenLimits
+	}{
+		{"claude-sonnet-4-20250514", TokenLimits{Summary: 4096, Order: 4096, Review: 16384}},
+		{"claude-opus-4-1-20250805", TokenLimits{Summary: 4096, Order: 4096, Review: 16384}},
+		{"claude-3-5-haiku-20241022", TokenLimits{Summary: 2048, Order: 2048, Review: 8192}},
+		{"claude-3.5-sonnet", TokenLimits{Summary: 2048, Order: 2048, Review: 8192}},
+		{"claude-3-haiku-20240307", TokenLimits{Summary: 2048, Order: 2048, Review: 4096}},
+		{"gpt-4o", TokenLimits{Summary: 4096, Order: 4096, Review: 16384}},
+		{"gpt-4o-mini", TokenLimits{Summary: 4096, Order: 4096, Review: 16384}},
+		{"gpt-4", TokenLimits{Summary: 2048, Order: 2048, Review: 4096}},
+		{"GPT-4", TokenLimits{Summary: 2048, Order: 2048, Review: 4096}},
+		{"gpt-3.5-turbo", TokenLimits{Summary: 1024, Order: 1024, Review: 4096}},
+		{"some-local-model", DefaultTokenLimits},
+		{"", DefaultTokenLimits},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.model, func(t *testing.T) {
+			got := TokenLimitsFor(tt.model)
+			if got != tt.want {
+				t.Errorf("TokenLimitsFor(%q) = %+v, want %+v", tt.model, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetTokenLimits_Override(t *testing.T) {
+	defer ResetTokenLimits()
+
+	custom := TokenLimits{Summary: 1000, Order: 500, Review: 3000}
+	SetTokenLimits("gpt-4o", custom)
+
+	if got := TokenLimitsFor("gpt-4o-2024-08-06"); got != custom {
+		t.Errorf("TokenLimitsFor() = %+v, want override %+v", got, custom)
+	}
+
+	// Models outside the override prefix keep the built-in table
+	if got := TokenLimitsFor("gpt-4"); got.Review != 4096 {
+		t.Errorf("gpt-4 Review = %d, want 4096", got.Review)
+	}
+
+	// An empty prefix covers every model, and zero fields keep the built-in budget
+	SetTokenLimits("", TokenLimits{Review: 2000})
+	want := TokenLimits{Summary: 2048, Order: 2048, Review: 2000}
+	if got := TokenLimitsFor("gpt-4"); got != want {
+		t.Errorf("gpt-4 = %+v, want %+v", got, want)
+	}
+	if got := TokenLimitsFor("gpt-4o"); got != custom {
+		t.Errorf("longer override prefix should win, got %+v", got)
+	}
+
+	ResetTokenLimits()
+	if got := TokenLimitsFor("gpt-4o"); got.Review != 16384 {
+		t.Errorf("after reset Review = %d, want 16384", got.Review)
+	}
+}
@@ -4,20 +4,23 @@ import (
 	"context"
 	"os"
 	"os/exec"
+	"strings"
 
 	"github.com/mwistrand/graft/internal/provider"
 )
 
 // deltaRenderer renders diffs through the Delta pager.
 type deltaRenderer struct {
-	deltaPath string
-	fallback  *fallbackRenderer
+	deltaPath   string
+	changesOnly bool
+	fallback    *fallbackRenderer
 }
 
 func newDeltaRenderer(deltaPath string, opts Options) *deltaRenderer {
 	return &deltaRenderer{
-		deltaPath: deltaPath,
-		fallback:  newFallbackRenderer(opts),
+		deltaPath:   deltaPath,
+		changesOnly: opts.ChangesOnly,
+		fallback:    newFallbackRenderer(opts),
 	}
 }
 
@@ -39,9 +42,16 @@ func (r *deltaRenderer) RenderFileHeader(file *provider.OrderedFile, fileNum, to
 	return r.fallback.RenderFileHeader(file, fileNum, totalFiles)
 }
 
+// RenderFlaggedLines displays the added lines that matched a concern keyword.
+// Delta output is not post-processed, so only files rendered by the fallback
+// renderer contribute flagged lines.
+func (r *deltaRenderer) RenderFlaggedLines() error {
+	return r.fallback.RenderFlaggedLines()
+}
+
 // RenderFileDiff displays the diff for a single file through Delta.
 func (r *deltaRenderer) RenderFileDiff(ctx context.Context, repoDir, baseRef, filePath string, fileNum, totalFiles int) error {
-	gitCmd := exec.CommandContext(ctx, "git", "diff", "--color=always", baseRef+"...HEAD", "--", filePath)
+	gitCmd := exec.CommandContext(ctx, "git", fileDiffArgs("--color=always", baseRef, filePath, r.changesOnly)...)
 	gitCmd.Dir = repoDir
 
 	deltaCmd := exec.CommandContext(ctx, r.deltaPath)
@@ -67,6 +77,27 @@ func (r *deltaRenderer) RenderFileDiff(ctx context.Context, repoDir, baseRef, fi
 	return deltaCmd.Wait()
 }
 
+// RenderPatch displays a file's section of a unified diff through Delta.
+// In changes-only mode the fallback renderer is used, since the patch
+// already contains its context lines.
+func (r *deltaRenderer) RenderPatch(ctx context.Context, filePath, patch string) error {
+	if r.changesOnly {
+		return r.fallback.RenderPatch(ctx, filePath, patch)
+	}
+
+	deltaCmd := exec.CommandContext(ctx, r.deltaPath)
+	deltaCmd.Stdin = strings.NewReader(patch)
+	deltaCmd.Stdout = os.Stdout
+	deltaCmd.Stderr = os.Stderr
+
+	// Only fall back when Delta never ran; once started it may already have
+	// written part of the patch
+	if err := deltaCmd.Start(); err != nil {
+		return r.fallback.RenderPatch(ctx, filePath, patch)
+	}
+	return deltaCmd.Wait()
+}
+
 // RenderFullDiff renders the complete diff through Delta.
 func (r *deltaRenderer) RenderFullDiff(ctx context.Context, repoDir, baseRef string) error {
 	gitCmd := exec.CommandContext(ctx, "git", "diff", "--color=always", baseRef+"...HEAD")
@@ -1,6 +1,7 @@
 package render
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io"
@@ -12,14 +13,19 @@ import (
 
 // fallbackRenderer renders diffs using basic git diff output.
 type fallbackRenderer struct {
-	output io.Writer
-	color  bool
+	output      io.Writer
+	color       bool
+	changesOnly bool
+	keywords    *keywordMatcher
+	flagged     []FlaggedLine
 }
 
 func newFallbackRenderer(opts Options) *fallbackRenderer {
 	return &fallbackRenderer{
-		output: opts.Output,
-		color:  opts.ColorEnabled,
+		output:      opts.Output,
+		color:       opts.ColorEnabled,
+		changesOnly: opts.ChangesOnly,
+		keywords:    newKeywordMatcher(opts.ConcernKeywords),
 	}
 }
 
@@ -132,11 +138,15 @@ func (r *fallbackRenderer) RenderFileHeader(file *provider.OrderedFile, fileNum,
 	r.writeDivider(w)
 
 	categoryIcon := getCategoryIcon(file.Category)
+	position := fmt.Sprintf("[%d/%d]", fileNum, totalFiles)
+	if totalFiles <= 0 {
+		position = fmt.Sprintf("[%d]", fileNum)
+	}
 	var header string
 	if file.Group != "" {
-		header = fmt.Sprintf("[%d/%d] %s -> %s %s", fileNum, totalFiles, file.Group, categoryIcon, file.Path)
+		header = fmt.Sprintf("%s %s -> %s %s", position, file.Group, categoryIcon, file.Path)
 	} else {
-		header = fmt.Sprintf("[%d/%d] %s %s", fileNum, totalFiles, categoryIcon, file.Path)
+		header = fmt.Sprintf("%s %s %s", position, categoryIcon, file.Path)
 	}
 	r.writeHighlight(w, header)
 
@@ -151,18 +161,118 @@ func (r *fallbackRenderer) RenderFileHeader(file *provider.OrderedFile, fileNum,
 }
 
 // RenderFileDiff displays the diff for a single file.
+// Added lines containing a concern keyword are highlighted and collected
+// for RenderFlaggedLines.
 func (r *fallbackRenderer) RenderFileDiff(ctx context.Context, repoDir, baseRef, filePath string, fileNum, totalFiles int) error {
+	// Compact output is colorized line by line, so git's own colors are not needed
 	colorFlag := "--color=never"
-	if r.color {
+	if r.color && !r.changesOnly {
 		colorFlag = "--color=always"
 	}
 
-	cmd := exec.CommandContext(ctx, "git", "diff", colorFlag, baseRef+"...HEAD", "--", filePath)
+	cmd := exec.CommandContext(ctx, "git", fileDiffArgs(colorFlag, baseRef, filePath, r.changesOnly)...)
 	cmd.Dir = repoDir
-	cmd.Stdout = r.output
+
+	var stdout bytes.Buffer
+	cmd.Stdout = &stdout
 	cmd.Stderr = r.output
 
-	return cmd.Run()
+	if err := cmd.Run(); err != nil {
+		return err
+	}
+
+	r.writeDiff(filePath, stdout.String(), false)
+	return nil
+}
+
+// RenderPatch displays a file's section of a unified diff.
+func (r *fallbackRenderer) RenderPatch(ctx context.Context, filePath, patch string) error {
+	r.writeDiff(filePath, patch, true)
+	return nil
+}
+
+// writeDiff post-processes diff output line by line.
+// If colorize is set, plain diff lines are colored here rather than by git.
+// In changes-only mode, git's extended headers are dropped since
+// RenderFileHeader already names the file.
+func (r *fallbackRenderer) writeDiff(filePath, diff string, colorize bool) {
+	if diff == "" {
+		return
+	}
+
+	w := r.output
+	inHunk := false
+	lineNum := 0
+	for _, line := range strings.Split(strings.TrimSuffix(diff, "\n"), "\n") {
+		plain := stripANSI(line)
+
+		switch {
+		case strings.HasPrefix(plain, "diff --git "):
+			inHunk = false
+		case strings.HasPrefix(plain, "@@"):
+			inHunk = true
+			lineNum = parseHunkStart(plain)
+		case !inHunk:
+			// Extended headers (index, mode, ---/+++ lines)
+		case strings.HasPrefix(plain, "+"):
+			if keyword := r.keywords.match(plain[1:]); keyword != "" {
+				r.flagged = append(r.flagged, FlaggedLine{
+					Path:    filePath,
+					Line:    lineNum,
+					Keyword: keyword,
+					Text:    plain[1:],
+				})
+				r.writeFlaggedLine(w, plain, keyword)
+				lineNum++
+				continue
+			}
+			lineNum++
+		case strings.HasPrefix(plain, " "):
+			lineNum++
+		}
+
+		if r.changesOnly || colorize {
+			r.writeDiffLine(w, plain, inHunk, r.changesOnly)
+		} else {
+			r.writeLine(w, line)
+		}
+	}
+}
+
+// writeDiffLine colors a plain diff line.
+// In compact mode, only hunk headers and changed lines are written.
+func (r *fallbackRenderer) writeDiffLine(w io.Writer, line string, inHunk, compact bool) {
+	switch {
+	case inHunk && strings.HasPrefix(line, "@@"):
+		r.writeHunkHeader(w, line)
+	case inHunk && strings.HasPrefix(line, "+"):
+		r.writeAddedLine(w, line)
+	case inHunk && strings.HasPrefix(line, "-"):
+		r.writeRemovedLine(w, line)
+	case !compact:
+		r.writeLine(w, line)
+	}
+}
+
+// RenderFlaggedLines displays the added lines that matched a concern keyword.
+// Writes nothing if no lines were flagged.
+func (r *fallbackRenderer) RenderFlaggedLines() error {
+	if len(r.flagged) == 0 {
+		return nil
+	}
+
+	w := r.output
+
+	r.writeLine(w, "")
+	r.writeHeader(w, "Flagged Lines")
+	r.writeLine(w, "")
+
+	for _, f := range r.flagged {
+		r.writeWarningBullet(w, fmt.Sprintf("%s:%d [%s] %s", f.Path, f.Line, f.Keyword, strings.TrimSpace(f.Text)))
+	}
+	r.writeLine(w, "")
+
+	return nil
 }
 
 func (r *fallbackRenderer) writeLine(w io.Writer, s string) {
@@ -209,6 +319,38 @@ func (r *fallbackRenderer) writeWarningBullet(w io.Writer, s string) {
 	}
 }
 
+func (r *fallbackRenderer) writeHunkHeader(w io.Writer, s string) {
+	if r.color {
+		fmt.Fprintf(w, "\033[36m%s\033[0m\n", s)
+	} else {
+		fmt.Fprintln(w, s)
+	}
+}
+
+func (r *fallbackRenderer) writeAddedLine(w io.Writer, s string) {
+	if r.color {
+		fmt.Fprintf(w, "\033[32m%s\033[0m\n", s)
+	} else {
+		fmt.Fprintln(w, s)
+	}
+}
+
+func (r *fallbackRenderer) writeRemovedLine(w io.Writer, s string) {
+	if r.color {
+		fmt.Fprintf(w, "\033[31m%s\033[0m\n", s)
+	} else {
+		fmt.Fprintln(w, s)
+	}
+}
+
+func (r *fallbackRenderer) writeFlaggedLine(w io.Writer, s, keyword string) {
+	if r.color {
+		fmt.Fprintf(w, "\033[30;43m%s\033[0m \033[1;33m⚑ %s\033[0m\n", s, keyword)
+	} else {
+		fmt.Fprintf(w, "%s  [flagged: %s]\n", s, keyword)
+	}
+}
+
 func (r *fallbackRenderer) writeDivider(w io.Writer) {
 	if r.color {
 		fmt.Fprintf(w, "\033[90m%s\033[0m\n", strings.Repeat("─", 60))
@@ -0,0 +1,90 @@
+package render
+
+import (
+	"regexp"
+	"strconv"
+)
+
+// FlaggedLine is an added line that matched a concern keyword.
+type FlaggedLine struct {
+	// Path is the file the line was added to.
+	Path string
+
+	// Line is the line number in the new version of the file.
+	Line int
+
+	// Keyword is the concern keyword that matched.
+	Keyword string
+
+	// Text is the added line content without the leading "+".
+	Text string
+}
+
+var (
+	ansiRegex       = regexp.MustCompile(`\x1b\[[0-9;]*m`)
+	hunkHeaderRegex = regexp.MustCompile(`^@@ -\d+(?:,\d+)? \+(\d+)(?:,\d+)? @@`)
+)
+
+// stripANSI removes ANSI color escape sequences from s.
+func stripANSI(s string) string {
+	return ansiRegex.ReplaceAllString(s, "")
+}
+
+// parseHunkStart returns the first new-file line number from a hunk header.
+// Returns 0 if the header cannot be parsed.
+func parseHunkStart(header string) int {
+	matches := hunkHeaderRegex.FindStringSubmatch(header)
+	if len(matches) != 2 {
+		return 0
+	}
+	n, _ := strconv.Atoi(matches[1])
+	return n
+}
+
+// keywordMatcher finds concern keywords in text. Keywords match whole words
+// only, ignoring case, so "exec" flags "exec.Command" but not "execute".
+type keywordMatcher struct {
+	keywords []string
+	patterns []*regexp.Regexp
+}
+
+// newKeywordMatcher compiles a matcher for keywords. Empty keywords are ignored.
+func newKeywordMatcher(keywords []string) *keywordMatcher {
+	m := &keywordMatcher{}
+	for _, k := range keywords {
+		if k == "" {
+			continue
+		}
+		m.keywords = append(m.keywords, k)
+		m.patterns = append(m.patterns, regexp.MustCompile(keywordPattern(k)))
+	}
+	return m
+}
+
+// keywordPattern builds a case-insensitive pattern for k. Word boundaries are
+// only required next to word characters, so keywords like "eval(" still match.
+func keywordPattern(k string) string {
+	pattern := "(?i)" + regexp.QuoteMeta(k)
+	if isWordChar(k[0]) {
+		pattern = `(?i)\b` + regexp.QuoteMeta(k)
+	}
+	if isWordChar(k[len(k)-1]) {
+		pattern += `\b`
+	}
+	return pattern
+}
+
+// isWordChar reports whether c is matched by \w.
+func isWordChar(c byte) bool {
+	return c == '_' || ('0' <= c && c <= '9') || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z')
+}
+
+// match returns the first keyword found in text, or an empty string if none match.
+func (m *keywordMatcher) match(text string) string {
+	for i, re := range m.patterns {
+		if re.MatchString(text) {
+			return m.keywords[i]
+		}
+	}
+	return ""
+}
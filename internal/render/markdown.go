@@ -0,0 +1,107 @@
+package render
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/mwistrand/graft/internal/provider"
+)
+
+// WriteMarkdownReport writes the summary and review order as a Markdown document,
+// suitable for job summaries, PR comments, or files. Either summary or order may
+// be nil, in which case its section is omitted.
+func WriteMarkdownReport(w io.Writer, title string, summary *provider.SummarizeResponse, order *provider.OrderResponse) error {
+	var b strings.Builder
+
+	if title != "" {
+		fmt.Fprintf(&b, "## %s\n\n", title)
+	}
+
+	if summary != nil {
+		writeMarkdownSummary(&b, summary)
+	}
+	if order != nil {
+		writeMarkdownOrdering(&b, order)
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// writeMarkdownSummary writes the summary section of a Markdown report.
+func writeMarkdownSummary(b *strings.Builder, summary *provider.SummarizeResponse) {
+	b.WriteString("### Change Summary\n\n")
+
+	if summary.Overview != "" {
+		b.WriteString(summary.Overview + "\n\n")
+	}
+
+	if len(summary.KeyChanges) > 0 {
+		b.WriteString("#### Key Changes\n\n")
+		for _, change := range summary.KeyChanges {
+			fmt.Fprintf(b, "- %s\n", change)
+		}
+		b.WriteString("\n")
+	}
+
+	if len(summary.Concerns) > 0 {
+		b.WriteString("#### Concerns\n\n")
+		for _, concern := range summary.Concerns {
+			fmt.Fprintf(b, "- :warning: %s\n", concern)
+		}
+		b.WriteString("\n")
+	}
+
+	if len(summary.FileGroups) > 0 {
+		b.WriteString("#### File Groups\n\n")
+		for _, group := range summary.FileGroups {
+			fmt.Fprintf(b, "- **%s**: %s\n", group.Name, group.Description)
+			for _, file := range group.Files {
+				fmt.Fprintf(b, "  - `%s`\n", file)
+			}
+		}
+		b.WriteString("\n")
+	}
+}
+
+// writeMarkdownOrdering writes the review order section of a Markdown report.
+func writeMarkdownOrdering(b *strings.Builder, order *provider.OrderResponse) {
+	b.WriteString("### Review Order\n\n")
+
+	if order.Reasoning != "" {
+		b.WriteString(order.Reasoning + "\n\n")
+	}
+
+	if len(order.Groups) > 0 {
+		b.WriteString("#### Groups\n\n")
+		for i, group := range order.Groups {
+			fmt.Fprintf(b, "%d. **%s** (%d files)", i+1, group.Name, countFilesInGroup(order.Files, group.Name))
+			if group.Description != "" {
+				fmt.Fprintf(b, ": %s", group.Description)
+			}
+			b.WriteString("\n")
+		}
+		b.WriteString("\n")
+	}
+
+	if len(order.Files) > 0 {
+		b.WriteString("| # | File | Category | Group | Description |\n")
+		b.WriteString("|---|------|----------|-------|-------------|\n")
+		for i, file := range order.Files {
+			fmt.Fprintf(b, "| %d | `%s` | %s | %s | %s |\n",
+				i+1,
+				markdownCell(file.Path),
+				markdownCell(file.Category),
+				markdownCell(file.Group),
+				markdownCell(file.Description))
+		}
+		b.WriteString("\n")
+	}
+}
+
+// markdownCell escapes text for use in a Markdown table cell.
+func markdownCell(s string) string {
+	s = strings.ReplaceAll(s, "|", `\|`)
+	return strings.Join(strings.Fields(s), " ")
+}
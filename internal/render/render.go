@@ -23,8 +23,16 @@ type Renderer interface {
 	// RenderFileDiff displays the diff for a single file.
 	RenderFileDiff(ctx context.Context, repoDir, baseRef, filePath string, fileNum, totalFiles int) error
 
+	// RenderPatch displays a file's section of a unified diff that did not
+	// come from the repository (e.g. a patch file).
+	RenderPatch(ctx context.Context, filePath, patch string) error
+
 	// RenderFileHeader displays a header for a file before its diff.
+	// A totalFiles of 0 means the total is not known yet.
 	RenderFileHeader(file *provider.OrderedFile, fileNum, totalFiles int) error
+
+	// RenderFlaggedLines displays the added lines that matched a concern keyword.
+	RenderFlaggedLines() error
 }
 
 // Options configures the renderer.
@@ -40,6 +48,14 @@ type Options struct {
 
 	// ColorEnabled controls whether ANSI colors are used.
 	ColorEnabled bool
+
+	// ChangesOnly hides unchanged context lines, showing only added and
+	// removed lines with their file and hunk headers.
+	ChangesOnly bool
+
+	// ConcernKeywords are highlighted when they appear in added lines.
+	// Matching lines are collected for RenderFlaggedLines.
+	ConcernKeywords []string
 }
 
 // DefaultOptions returns sensible defaults.
@@ -75,6 +91,16 @@ func New(opts Options) Renderer {
 	return newFallbackRenderer(opts)
 }
 
+// fileDiffArgs builds the git diff arguments for a single file.
+// When changesOnly is set, context lines are suppressed with -U0.
+func fileDiffArgs(colorFlag, baseRef, filePath string, changesOnly bool) []string {
+	args := []string{"diff", colorFlag}
+	if changesOnly {
+		args = append(args, "-U0")
+	}
+	return append(args, baseRef+"...HEAD", "--", filePath)
+}
+
 // IsDeltaAvailable checks if delta is available on the system.
 func IsDeltaAvailable() bool {
 	_, err := exec.LookPath("delta")
@@ -5,6 +5,7 @@ import (
 	"context"
 	"os/exec"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/mwistrand/graft/internal/provider"
@@ -165,6 +166,20 @@ func TestFallbackRenderer_RenderFileHeader(t *testing.T) {
 	}
 }
 
+func TestFallbackRenderer_RenderFileHeader_UnknownTotal(t *testing.T) {
+	buf := new(bytes.Buffer)
+	r := newFallbackRenderer(Options{Output: buf, ColorEnabled: false})
+
+	if err := r.RenderFileHeader(&provider.OrderedFile{Path: "a.go"}, 2, 0); err != nil {
+		t.Fatalf("RenderFileHeader() failed: %v", err)
+	}
+
+	output := buf.String()
+	if !containsString(output, "[2] ") || containsString(output, "[2/") {
+		t.Errorf("expected file number without a total, got %q", output)
+	}
+}
+
 func TestFallbackRenderer_RenderFileDiff(t *testing.T) {
 	// Create a temporary git repo
 	dir := t.TempDir()
@@ -203,6 +218,197 @@ func TestFallbackRenderer_RenderFileDiff(t *testing.T) {
 	}
 }
 
+func TestFallbackRenderer_RenderFileDiff_ChangesOnly(t *testing.T) {
+	dir := t.TempDir()
+
+	runGit(t, dir, "init")
+	runGit(t, dir, "config", "user.email", "test@example.com")
+	runGit(t, dir, "config", "user.name", "Test User")
+
+	writeFile(t, dir, "test.go", "package main\n\nimport \"fmt\"\n\nfunc helper() {}\n\nfunc main() {\n\tfmt.Println(1)\n}")
+	runGit(t, dir, "add", "test.go")
+	runGit(t, dir, "commit", "-m", "Initial commit")
+
+	branch := getCurrentBranch(t, dir)
+
+	runGit(t, dir, "checkout", "-b", "feature")
+	writeFile(t, dir, "test.go", "package main\n\nimport \"fmt\"\n\nfunc helper() {}\n\nfunc main() {\n\tfmt.Println(2)\n}")
+	runGit(t, dir, "add", "test.go")
+	runGit(t, dir, "commit", "-m", "Change output")
+
+	buf := new(bytes.Buffer)
+	r := newFallbackRenderer(Options{Output: buf, ColorEnabled: false, ChangesOnly: true})
+
+	err := r.RenderFileDiff(context.Background(), dir, branch, "test.go", 1, 1)
+	if err != nil {
+		t.Fatalf("RenderFileDiff() failed: %v", err)
+	}
+
+	output := buf.String()
+
+	// Changed lines and hunk headers remain
+	if !containsString(output, "-\tfmt.Println(1)") {
+		t.Error("output should contain removed line")
+	}
+	if !containsString(output, "+\tfmt.Println(2)") {
+		t.Error("output should contain added line")
+	}
+	if !containsString(output, "@@") {
+		t.Error("output should contain hunk header")
+	}
+
+	// Context lines and extended headers are absent
+	if containsString(output, "func helper()") {
+		t.Error("output should not contain context lines")
+	}
+	if containsString(output, "import \"fmt\"") {
+		t.Error("output should not contain context lines")
+	}
+	if containsString(output, "diff --git") || containsString(output, "+++ b/test.go") {
+		t.Error("output should not contain git extended headers")
+	}
+}
+
+func TestFallbackRenderer_RenderFileDiff_FlagsConcernKeywords(t *testing.T) {
+	dir := t.TempDir()
+
+	runGit(t, dir, "init")
+	runGit(t, dir, "config", "user.email", "test@example.com")
+	runGit(t, dir, "config", "user.name", "Test User")
+
+	writeFile(t, dir, "test.go", "package main\n\n// TODO: existing note")
+	runGit(t, dir, "add", "test.go")
+	runGit(t, dir, "commit", "-m", "Initial commit")
+
+	branch := getCurrentBranch(t, dir)
+
+	runGit(t, dir, "checkout", "-b", "feature")
+	writeFile(t, dir, "test.go", "package main\n\n// TODO: existing note\n\nfunc main() {\n\tpanic(1)\n}")
+	runGit(t, dir, "add", "test.go")
+	runGit(t, dir, "commit", "-m", "Add main function")
+
+	buf := new(bytes.Buffer)
+	r := newFallbackRenderer(Options{Output: buf, ColorEnabled: false, ConcernKeywords: []string{"TODO", "PANIC"}})
+
+	err := r.RenderFileDiff(context.Background(), dir, branch, "test.go", 1, 1)
+	if err != nil {
+		t.Fatalf("RenderFileDiff() failed: %v", err)
+	}
+
+	output := buf.String()
+
+	if !containsString(output, "+\tpanic(1)  [flagged: PANIC]") {
+		t.Errorf("added line with keyword should be highlighted, got:\n%s", output)
+	}
+	if containsString(output, "existing note  [flagged") {
+		t.Error("context lines should not be flagged")
+	}
+
+	// Only the added line is collected
+	if len(r.flagged) != 1 {
+		t.Fatalf("expected 1 flagged line, got %d: %v", len(r.flagged), r.flagged)
+	}
+	got := r.flagged[0]
+	if got.Path != "test.go" || got.Line != 6 || got.Keyword != "PANIC" || got.Text != "\tpanic(1)" {
+		t.Errorf("unexpected flagged line: %+v", got)
+	}
+
+	buf.Reset()
+	if err := r.RenderFlaggedLines(); err != nil {
+		t.Fatalf("RenderFlaggedLines() failed: %v", err)
+	}
+	output = buf.String()
+	if !containsString(output, "Flagged Lines") {
+		t.Error("output should contain 'Flagged Lines' header")
+	}
+	if !containsString(output, "test.go:6 [PANIC] panic(1)") {
+		t.Errorf("output should list the flagged line, got:\n%s", output)
+	}
+}
+
+func TestFallbackRenderer_RenderFlaggedLines_Empty(t *testing.T) {
+	buf := new(bytes.Buffer)
+	r := newFallbackRenderer(Options{Output: buf, ColorEnabled: false})
+
+	if err := r.RenderFlaggedLines(); err != nil {
+		t.Fatalf("RenderFlaggedLines() failed: %v", err)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("expected no output when nothing is flagged, got %q", buf.String())
+	}
+}
+
+func TestKeywordMatcher(t *testing.T) {
+	m := newKeywordMatcher([]string{"TODO", "password", "exec", "eval", "", "eval("})
+
+	tests := []struct {
+		text string
+		want string
+	}{
+		{"// todo: remove", "TODO"},
+		{"Password := os.Getenv(\"PW\")", "password"},
+		{"fmt.Println(x)", ""},
+		{"cmd := exec.Command(\"ls\")", "exec"},
+		{"// execute the plan", ""},
+		{"result := evaluate(expr)", ""},
+		{"era := \"medieval\"", ""},
+		{"x = eval (code)", "eval"},
+		{"x=window.eval(code)", "eval"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			if got := m.match(tt.text); got != tt.want {
+				t.Errorf("match(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+
+	// Keywords ending in punctuation match without a trailing word boundary
+	if got := newKeywordMatcher([]string{"eval("}).match("x=eval(code)"); got != "eval(" {
+		t.Errorf("match() = %q, want %q", got, "eval(")
+	}
+}
+
+func TestFallbackRenderer_RenderPatch(t *testing.T) {
+	patch := "diff --git a/a.go b/a.go\n--- a/a.go\n+++ b/a.go\n@@ -1,2 +1,2 @@\n package a\n-var x = 1\n+var x = 2\n"
+
+	buf := new(bytes.Buffer)
+	r := newFallbackRenderer(Options{Output: buf, ColorEnabled: false, ChangesOnly: true})
+
+	if err := r.RenderPatch(context.Background(), "a.go", patch); err != nil {
+		t.Fatalf("RenderPatch() failed: %v", err)
+	}
+
+	output := buf.String()
+	if !containsString(output, "-var x = 1") || !containsString(output, "+var x = 2") {
+		t.Errorf("output should contain changed lines, got:\n%s", output)
+	}
+	if containsString(output, "package a") {
+		t.Error("output should not contain context lines in changes-only mode")
+	}
+}
+
+func TestFileDiffArgs(t *testing.T) {
+	args := fileDiffArgs("--color=never", "main", "a.go", false)
+	for _, a := range args {
+		if a == "-U0" {
+			t.Error("expected no -U0 flag when changesOnly is false")
+		}
+	}
+
+	args = fileDiffArgs("--color=never", "main", "a.go", true)
+	found := false
+	for _, a := range args {
+		if a == "-U0" {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("expected -U0 flag when changesOnly is true, got %v", args)
+	}
+}
+
 func TestGetCategoryIcon(t *testing.T) {
 	tests := []struct {
 		category string
@@ -418,3 +624,67 @@ func containsString(s, substr string) bool {
 	}
 	return false
 }
+
+func TestWriteMarkdownReport(t *testing.T) {
+	summary := &provider.SummarizeResponse{
+		Overview:   "Adds retry logic to uploads.",
+		KeyChanges: []string{"Retry failed uploads"},
+		Concerns:   []string{"Backoff is unbounded"},
+		FileGroups: []provider.FileGroup{
+			{Name: "Uploads", Description: "Upload client", Files: []string{"upload.go"}},
+		},
+	}
+	order := &provider.OrderResponse{
+		Reasoning: "Client first, then tests.",
+		Groups:    []provider.OrderGroup{{Name: "Uploads", Description: "Retry support", Priority: 1}},
+		Files: []provider.OrderedFile{
+			{Path: "upload.go", Category: "business_logic", Group: "Uploads", Description: "Retries | backoff"},
+			{Path: "upload_test.go", Category: "test", Group: "Uploads"},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := WriteMarkdownReport(&buf, "Graft Review", summary, order); err != nil {
+		t.Fatalf("WriteMarkdownReport() failed: %v", err)
+	}
+	output := buf.String()
+
+	expected := []string{
+		"## Graft Review\n",
+		"### Change Summary",
+		"Adds retry logic to uploads.",
+		"- Retry failed uploads",
+		"- :warning: Backoff is unbounded",
+		"- **Uploads**: Upload client",
+		"  - `upload.go`",
+		"### Review Order",
+		"Client first, then tests.",
+		"1. **Uploads** (2 files): Retry support",
+		"| 1 | `upload.go` | business_logic | Uploads | Retries \\| backoff |",
+		"| 2 | `upload_test.go` | test | Uploads |  |",
+	}
+	for _, want := range expected {
+		if !strings.Contains(output, want) {
+			t.Errorf("report should contain %q\n%s", want, output)
+		}
+	}
+}
+
+func TestWriteMarkdownReport_PartialSections(t *testing.T) {
+	var buf bytes.Buffer
+	order := &provider.OrderResponse{Files: []provider.OrderedFile{{Path: "main.go"}}}
+	if err := WriteMarkdownReport(&buf, "", nil, order); err != nil {
+		t.Fatalf("WriteMarkdownReport() failed: %v", err)
+	}
+
+	output := buf.String()
+	if strings.Contains(output, "Change Summary") {
+		t.Error("report should omit the summary section when summary is nil")
+	}
+	if !strings.HasPrefix(output, "### Review Order") {
+		t.Errorf("report without a title should start with the ordering, got %q", output)
+	}
+	if !strings.Contains(output, "`main.go`") {
+		t.Error("report should include the ordering")
+	}
+}